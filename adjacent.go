@@ -0,0 +1,32 @@
+package steams
+
+// Pairwise returns an iterator over every pair of adjacent elements,
+// yielding (previous, current) for each element after the first.
+func Pairwise[T any](i It[T]) It[Pair[T, T]] {
+	return Diff(i, func(prev, cur T) Pair[T, T] {
+		return Pair[T, T]{First: prev, Second: cur}
+	})
+}
+
+// Diff returns an iterator that applies the delta function to every pair
+// of adjacent elements, such as the difference between consecutive readings.
+// It yields one element less than the input.
+func Diff[T, R any](i It[T], delta func(prev, cur T) R) It[R] {
+	return func(yield func(R) bool) {
+		first := true
+		var prev T
+		for v := range i {
+			if !first && !yield(delta(prev, v)) {
+				return
+			}
+			first = false
+			prev = v
+		}
+	}
+}
+
+// IsStrictlyIncreasing returns true if every element is greater than the
+// one before it. It short-circuits on the first element that is not.
+func IsStrictlyIncreasing[T Ordered](i It[T]) bool {
+	return i.AdjacentFind(func(a, b T) bool { return a >= b }).IsNil()
+}
@@ -0,0 +1,27 @@
+package steams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPairwise(t *testing.T) {
+	result := Pairwise(From(1, 2, 4)).Collect()
+	assert.Equal(t, []Pair[int, int]{{1, 2}, {2, 4}}, result)
+	assert.Len(t, Pairwise(From(1)).Collect(), 0)
+}
+
+func TestDiff(t *testing.T) {
+	readings := From(10.0, 12.5, 12.0, 15.0)
+	deltas := Diff(readings, func(prev, cur float64) float64 { return cur - prev })
+	assert.Equal(t, []float64{2.5, -0.5, 3}, deltas.Collect())
+	assert.Equal(t, []float64{2.5}, deltas.Take(1).Collect())
+}
+
+func TestIsStrictlyIncreasing(t *testing.T) {
+	assert.True(t, IsStrictlyIncreasing(From(1, 2, 5)))
+	assert.False(t, IsStrictlyIncreasing(From(1, 2, 2)))
+	assert.True(t, IsStrictlyIncreasing(From("a", "b")))
+	assert.True(t, IsStrictlyIncreasing(From[int]()))
+}
@@ -0,0 +1,223 @@
+package steams
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"math"
+	"math/bits"
+)
+
+// bloomMagic identifies the binary encoding produced by BloomFilter.MarshalBinary.
+const bloomMagic = "STBF\x01"
+
+// Sizing applied by NewBloomFilter to invalid arguments and by Add to a
+// zero-value BloomFilter.
+const (
+	defaultBloomExpectedN         = 1000
+	defaultBloomFalsePositiveRate = 0.01
+)
+
+// maxBloomHashes bounds the number of hash functions per key. More than 64
+// only matters for false positive rates far below 2^-64, and the bound keeps
+// a corrupted encoding from making every Add and Contains run for ages.
+const maxBloomHashes = 64
+
+// BloomFilter is a probabilistic set used to test whether a key has been
+// seen before. It can report false positives, but never false negatives.
+// Keys are hashed with FNV-1a, so a filter marshaled with MarshalBinary
+// can be reloaded in a later run and keep answering consistently.
+// The zero value is an empty filter; the first Add sizes it for 1000 keys
+// with a 1% false positive rate. Use NewBloomFilter to choose the size.
+// A BloomFilter is not safe for concurrent use.
+type BloomFilter struct {
+	words    []uint64
+	bits     uint64
+	hashes   uint64
+	added    uint64
+	checked  uint64
+	rejected uint64
+}
+
+// BloomStats holds the counters and the estimated accuracy of a BloomFilter.
+type BloomStats struct {
+	// Checked is the number of keys tested with TestAndAdd.
+	Checked uint64
+	// Added is the number of keys inserted into the filter.
+	Added uint64
+	// Rejected is the number of keys TestAndAdd reported as already present.
+	Rejected uint64
+	// FillRatio is the fraction of bits currently set.
+	FillRatio float64
+	// EstimatedFalsePositiveRate is the probability that a key never added
+	// is reported as present, given the current fill ratio.
+	EstimatedFalsePositiveRate float64
+}
+
+// NewBloomFilter creates a BloomFilter sized to hold expectedN keys with
+// the given false positive rate. A non-positive expectedN is treated as 1
+// and a rate outside (0, 1) is treated as 0.01.
+func NewBloomFilter(expectedN int, falsePositiveRate float64) *BloomFilter {
+	if expectedN <= 0 {
+		expectedN = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = defaultBloomFalsePositiveRate
+	}
+
+	n := float64(expectedN)
+	m := math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	k := math.Min(maxBloomHashes, math.Max(1, math.Round(m/n*math.Ln2)))
+
+	size := uint64(m)
+	return &BloomFilter{
+		words:  make([]uint64, (size+63)/64),
+		bits:   size,
+		hashes: uint64(k),
+	}
+}
+
+// Add inserts the key into the filter.
+func (b *BloomFilter) Add(key string) {
+	if b.bits == 0 {
+		sized := NewBloomFilter(defaultBloomExpectedN, defaultBloomFalsePositiveRate)
+		b.words, b.bits, b.hashes = sized.words, sized.bits, sized.hashes
+	}
+	h1, h2 := bloomHash(key)
+	for i := range b.hashes {
+		pos := (h1 + i*h2) % b.bits
+		b.words[pos/64] |= 1 << (pos % 64)
+	}
+	b.added++
+}
+
+// Contains reports whether the key may have been added to the filter.
+// A false result is always correct; a true result may be a false positive.
+func (b *BloomFilter) Contains(key string) bool {
+	if b.bits == 0 {
+		return false
+	}
+	h1, h2 := bloomHash(key)
+	for i := range b.hashes {
+		pos := (h1 + i*h2) % b.bits
+		if b.words[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// TestAndAdd reports whether the key may already be in the filter and
+// adds it when it is not. The result is recorded in the filter stats.
+func (b *BloomFilter) TestAndAdd(key string) bool {
+	b.checked++
+	if b.Contains(key) {
+		b.rejected++
+		return true
+	}
+	b.Add(key)
+	return false
+}
+
+// Stats returns the current counters of the filter.
+func (b *BloomFilter) Stats() BloomStats {
+	set := 0
+	for _, w := range b.words {
+		set += bits.OnesCount64(w)
+	}
+	stats := BloomStats{
+		Checked:  b.checked,
+		Added:    b.added,
+		Rejected: b.rejected,
+	}
+	if b.bits > 0 {
+		stats.FillRatio = float64(set) / float64(b.bits)
+		stats.EstimatedFalsePositiveRate = math.Pow(stats.FillRatio, float64(b.hashes))
+	}
+	return stats
+}
+
+// MarshalBinary encodes the filter, including its stats, so it can be
+// persisted and reloaded with UnmarshalBinary.
+func (b *BloomFilter) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, len(bloomMagic)+5*8+len(b.words)*8)
+	buf = append(buf, bloomMagic...)
+	for _, v := range []uint64{b.bits, b.hashes, b.added, b.checked, b.rejected} {
+		buf = binary.BigEndian.AppendUint64(buf, v)
+	}
+	for _, w := range b.words {
+		buf = binary.BigEndian.AppendUint64(buf, w)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary restores a filter previously encoded with MarshalBinary.
+// The encoding of a zero-value filter restores an empty zero-value filter.
+func (b *BloomFilter) UnmarshalBinary(data []byte) error {
+	header := len(bloomMagic) + 5*8
+	if len(data) < header || string(data[:len(bloomMagic)]) != bloomMagic {
+		return errors.New("steams: invalid bloom filter encoding")
+	}
+
+	fields := make([]uint64, 5)
+	for i := range fields {
+		offset := len(bloomMagic) + i*8
+		fields[i] = binary.BigEndian.Uint64(data[offset:])
+	}
+
+	size, hashes := fields[0], fields[1]
+	payload := len(data) - header
+	words := uint64(payload / 8)
+	empty := size == 0 && hashes == 0 && payload == 0
+	sized := size > 0 && hashes > 0 && hashes <= maxBloomHashes &&
+		payload%8 == 0 && (size-1)/64+1 == words
+	if !empty && !sized {
+		return errors.New("steams: invalid bloom filter encoding")
+	}
+
+	b.bits, b.hashes = size, hashes
+	b.added, b.checked, b.rejected = fields[2], fields[3], fields[4]
+	b.words = make([]uint64, words)
+	for i := range b.words {
+		b.words[i] = binary.BigEndian.Uint64(data[header+i*8:])
+	}
+	return nil
+}
+
+// bloomHash derives the two base hashes used for double hashing.
+func bloomHash(key string) (uint64, uint64) {
+	h := fnv.New128a()
+	h.Write([]byte(key))
+	sum := h.Sum(nil)
+	h1 := binary.BigEndian.Uint64(sum[:8])
+	h2 := binary.BigEndian.Uint64(sum[8:]) | 1
+	return h1, h2
+}
+
+// DistinctApprox returns an iterator that drops elements whose key was
+// probably seen before, using a BloomFilter sized for expectedN keys and
+// the given false positive rate. Memory stays fixed regardless of the
+// number of elements, at the cost of occasionally dropping an element
+// that was not a duplicate. The filter is returned so its stats can be
+// inspected and it can be persisted between runs.
+func DistinctApprox[T any](i It[T], keyFunc func(T) string, expectedN int, falsePositiveRate float64) (It[T], *BloomFilter) {
+	filter := NewBloomFilter(expectedN, falsePositiveRate)
+	return DistinctApproxWith(i, keyFunc, filter), filter
+}
+
+// DistinctApproxWith is a DistinctApprox variant that uses an existing
+// filter, for example one reloaded with UnmarshalBinary. Keys are added to
+// the filter as the iterator is consumed, so iterating twice drops the
+// elements yielded by the first pass.
+func DistinctApproxWith[T any](i It[T], keyFunc func(T) string, filter *BloomFilter) It[T] {
+	return func(yield func(T) bool) {
+		for v := range i {
+			if filter.TestAndAdd(keyFunc(v)) {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
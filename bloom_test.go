@@ -0,0 +1,110 @@
+package steams
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBloomFilter(t *testing.T) {
+	filter := NewBloomFilter(1000, 0.01)
+	for i := range 1000 {
+		filter.Add(strconv.Itoa(i))
+	}
+	for i := range 1000 {
+		assert.True(t, filter.Contains(strconv.Itoa(i)))
+	}
+
+	falsePositives := 0
+	for i := 1000; i < 11000; i++ {
+		if filter.Contains(strconv.Itoa(i)) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, falsePositives, 300, "false positive rate should stay close to 1%")
+
+	stats := filter.Stats()
+	assert.Equal(t, uint64(1000), stats.Added)
+	assert.InDelta(t, 0.01, stats.EstimatedFalsePositiveRate, 0.01)
+}
+
+func TestBloomFilterMarshal(t *testing.T) {
+	filter := NewBloomFilter(100, 0.01)
+	assert.False(t, filter.TestAndAdd("a"))
+	assert.True(t, filter.TestAndAdd("a"))
+
+	data, err := filter.MarshalBinary()
+	assert.NoError(t, err)
+
+	var restored BloomFilter
+	assert.NoError(t, restored.UnmarshalBinary(data))
+	assert.True(t, restored.Contains("a"))
+	assert.Equal(t, filter.Stats(), restored.Stats())
+
+	assert.Error(t, restored.UnmarshalBinary([]byte("nope")))
+	assert.Error(t, restored.UnmarshalBinary(data[:len(data)-1]))
+}
+
+func TestBloomFilterZeroValue(t *testing.T) {
+	var filter BloomFilter
+	assert.False(t, filter.Contains("a"))
+	assert.Equal(t, BloomStats{}, filter.Stats())
+
+	assert.Error(t, filter.UnmarshalBinary([]byte("nope")))
+	assert.False(t, filter.TestAndAdd("a"))
+	assert.True(t, filter.Contains("a"))
+	assert.Equal(t, uint64(1), filter.Stats().Checked)
+	assert.Equal(t, uint64(1), filter.Stats().Added)
+}
+
+func TestBloomFilterMarshalZeroValue(t *testing.T) {
+	var filter BloomFilter
+	data, err := filter.MarshalBinary()
+	assert.NoError(t, err)
+
+	var restored BloomFilter
+	assert.NoError(t, restored.UnmarshalBinary(data))
+	assert.False(t, restored.Contains("a"))
+	restored.Add("a")
+	assert.True(t, restored.Contains("a"))
+}
+
+func TestBloomFilterUnmarshalRejectsCorruptHeader(t *testing.T) {
+	data, err := NewBloomFilter(100, 0.01).MarshalBinary()
+	assert.NoError(t, err)
+
+	hashesOffset := len(bloomMagic) + 8
+	corrupt := append([]byte{}, data...)
+	binary.BigEndian.PutUint64(corrupt[hashesOffset:], 1<<63)
+	var restored BloomFilter
+	assert.Error(t, restored.UnmarshalBinary(corrupt))
+
+	corrupt = append([]byte{}, data...)
+	binary.BigEndian.PutUint64(corrupt[len(bloomMagic):], math.MaxUint64)
+	assert.Error(t, restored.UnmarshalBinary(corrupt))
+
+	assert.LessOrEqual(t, NewBloomFilter(10, 1e-300).hashes, uint64(maxBloomHashes))
+}
+
+func TestDistinctApprox(t *testing.T) {
+	ids := From(1, 2, 3, 2, 1, 4)
+	result, filter := DistinctApprox(ids, func(i int) string { return fmt.Sprint(i) }, 100, 0.001)
+	assert.Equal(t, []int{1, 2, 3, 4}, result.Collect())
+
+	stats := filter.Stats()
+	assert.Equal(t, uint64(6), stats.Checked)
+	assert.Equal(t, uint64(4), stats.Added)
+	assert.Equal(t, uint64(2), stats.Rejected)
+}
+
+func TestDistinctApproxWith(t *testing.T) {
+	filter := NewBloomFilter(100, 0.001)
+	toKey := func(s string) string { return s }
+
+	assert.Equal(t, []string{"a", "b"}, DistinctApproxWith(From("a", "b", "a"), toKey, filter).Collect())
+	assert.Equal(t, []string{"c"}, DistinctApproxWith(From("b", "c"), toKey, filter).Collect())
+}
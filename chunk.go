@@ -0,0 +1,85 @@
+package steams
+
+// ChunkBy groups adjacent elements that share the same key, yielding each
+// key together with its run of elements. Unlike GroupBy, it streams the
+// input and only keeps the current run in memory, so a key appears once
+// per run rather than once overall.
+func ChunkBy[K comparable, V any](i It[V], keyFunc func(V) K) It2[K, It[V]] {
+	return func(yield func(K, It[V]) bool) {
+		var run []V
+		var current K
+		for v := range i {
+			key := keyFunc(v)
+			if len(run) > 0 && key != current {
+				if !yield(current, FromSlice(run)) {
+					return
+				}
+				run = nil
+			}
+			current = key
+			run = append(run, v)
+		}
+		if len(run) > 0 {
+			yield(current, FromSlice(run))
+		}
+	}
+}
+
+// RunLengthEncode collapses runs of equal adjacent elements into pairs of
+// the element and the length of its run. It requires O(1) memory.
+func RunLengthEncode[T comparable](i It[T]) It2[T, int] {
+	return func(yield func(T, int) bool) {
+		var current T
+		count := 0
+		for v := range i {
+			if count > 0 && v != current {
+				if !yield(current, count) {
+					return
+				}
+				count = 0
+			}
+			current = v
+			count++
+		}
+		if count > 0 {
+			yield(current, count)
+		}
+	}
+}
+
+// RunLengthDecode expands element and count pairs, as produced by
+// RunLengthEncode, back into the original sequence. Pairs with a
+// non-positive count are skipped. Elements are never compared; T is only
+// comparable because It2 requires comparable keys.
+func RunLengthDecode[T comparable](i It2[T, int]) It[T] {
+	return func(yield func(T) bool) {
+		for v, count := range i {
+			for range count {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// SplitWhen splits the iterator into consecutive runs, starting a new run
+// between two adjacent elements whenever the predicate returns true for
+// them. Only the current run is kept in memory.
+func SplitWhen[T any](i It[T], predicate func(T, T) bool) It[It[T]] {
+	return func(yield func(It[T]) bool) {
+		var run []T
+		for v := range i {
+			if len(run) > 0 && predicate(run[len(run)-1], v) {
+				if !yield(FromSlice(run)) {
+					return
+				}
+				run = nil
+			}
+			run = append(run, v)
+		}
+		if len(run) > 0 {
+			yield(FromSlice(run))
+		}
+	}
+}
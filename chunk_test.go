@@ -0,0 +1,55 @@
+package steams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkBy(t *testing.T) {
+	words := From("apple", "avocado", "banana", "blueberry", "apricot")
+
+	var keys []byte
+	var runs [][]string
+	ChunkBy(words, func(s string) byte { return s[0] }).ForEach(func(k byte, run It[string]) {
+		keys = append(keys, k)
+		runs = append(runs, run.Collect())
+	})
+
+	assert.Equal(t, []byte{'a', 'b', 'a'}, keys)
+	assert.Equal(t, [][]string{{"apple", "avocado"}, {"banana", "blueberry"}, {"apricot"}}, runs)
+
+	count := 0
+	ChunkBy(words, func(s string) byte { return s[0] }).Take(1).ForEach(func(byte, It[string]) { count++ })
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 0, ChunkBy(From[string](), func(s string) byte { return s[0] }).Keys().Count())
+}
+
+func TestRunLengthEncode(t *testing.T) {
+	var values []string
+	var counts []int
+	RunLengthEncode(From("a", "a", "b", "c", "c", "c", "a")).ForEach(func(v string, c int) {
+		values = append(values, v)
+		counts = append(counts, c)
+	})
+
+	assert.Equal(t, []string{"a", "b", "c", "a"}, values)
+	assert.Equal(t, []int{2, 1, 3, 1}, counts)
+}
+
+func TestRunLengthDecode(t *testing.T) {
+	input := From(1, 1, 1, 2, 3, 3, 1)
+	assert.Equal(t, input.Collect(), RunLengthDecode(RunLengthEncode(input)).Collect())
+
+	pairs := ZipToIt2(From("x", "y", "z"), From(2, 0, 1))
+	assert.Equal(t, []string{"x", "x", "z"}, RunLengthDecode(pairs).Collect())
+}
+
+func TestSplitWhen(t *testing.T) {
+	runs := SplitWhen(From(1, 2, 3, 7, 8, 10), func(a, b int) bool { return b-a > 1 })
+	result := Map(runs, func(run It[int]) []int { return run.Collect() }).Collect()
+	assert.Equal(t, [][]int{{1, 2, 3}, {7, 8}, {10}}, result)
+
+	assert.Equal(t, 1, runs.Take(1).Count())
+	assert.Equal(t, 0, SplitWhen(From[int](), func(a, b int) bool { return true }).Count())
+}
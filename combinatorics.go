@@ -0,0 +1,191 @@
+package steams
+
+// Product returns an iterator over the Cartesian product of the input
+// iterators, yielding one slice per combination in lexicographic order
+// (the last iterator varies fastest). Each input is buffered once.
+// The yielded slice is freshly allocated and may be retained by the caller.
+func Product[T any](its ...It[T]) It[[]T] {
+	return func(yield func([]T) bool) {
+		if len(its) == 0 {
+			return
+		}
+
+		pools := make([][]T, len(its))
+		for idx, i := range its {
+			pools[idx] = i.Collect()
+			if len(pools[idx]) == 0 {
+				return
+			}
+		}
+
+		indices := make([]int, len(pools))
+		for {
+			tuple := make([]T, len(pools))
+			for idx, p := range indices {
+				tuple[idx] = pools[idx][p]
+			}
+
+			if !yield(tuple) {
+				return
+			}
+
+			pos := len(indices) - 1
+			for pos >= 0 && indices[pos] == len(pools[pos])-1 {
+				indices[pos] = 0
+				pos--
+			}
+			if pos < 0 {
+				return
+			}
+			indices[pos]++
+		}
+	}
+}
+
+// Combinations returns an iterator over every k-length combination of the
+// input elements, without repetition, in lexicographic order of position.
+// The input is buffered once; only the current tuple is kept besides it.
+func Combinations[T any](i It[T], k int) It[[]T] {
+	return combinations(i, k, false)
+}
+
+// CombinationsWithReplacement is a Combinations variant in which each
+// element may appear more than once in the same tuple.
+func CombinationsWithReplacement[T any](i It[T], k int) It[[]T] {
+	return combinations(i, k, true)
+}
+
+// combinations yields k-length combinations of the buffered input by
+// advancing an index tuple in lexicographic order.
+func combinations[T any](i It[T], k int, replacement bool) It[[]T] {
+	return func(yield func([]T) bool) {
+		if k < 0 {
+			return
+		}
+		pool := i.Collect()
+		n := len(pool)
+		if (!replacement && k > n) || (replacement && n == 0 && k > 0) {
+			return
+		}
+
+		indices := make([]int, k)
+		if !replacement {
+			for idx := range indices {
+				indices[idx] = idx
+			}
+		}
+
+		for {
+			if !yield(pick(pool, indices)) {
+				return
+			}
+
+			// Find the rightmost index that can still be incremented.
+			pos := k - 1
+			for pos >= 0 && indices[pos] == maxIndex(n, k, pos, replacement) {
+				pos--
+			}
+			if pos < 0 {
+				return
+			}
+
+			indices[pos]++
+			for next := pos + 1; next < k; next++ {
+				if replacement {
+					indices[next] = indices[pos]
+				} else {
+					indices[next] = indices[next-1] + 1
+				}
+			}
+		}
+	}
+}
+
+// maxIndex returns the highest pool index allowed at position pos of a
+// k-length combination over n elements.
+func maxIndex(n, k, pos int, replacement bool) int {
+	if replacement {
+		return n - 1
+	}
+	return pos + n - k
+}
+
+// Permutations returns an iterator over every k-length ordered arrangement
+// of the input elements, without repetition, in lexicographic order of
+// position. The input is buffered once; only the current tuple is kept
+// besides it.
+func Permutations[T any](i It[T], k int) It[[]T] {
+	return func(yield func([]T) bool) {
+		pool := i.Collect()
+		n := len(pool)
+		if k < 0 || k > n {
+			return
+		}
+
+		indices := make([]int, k)
+		used := make([]bool, n)
+		for idx := range indices {
+			indices[idx] = idx
+			used[idx] = true
+		}
+
+		for {
+			if !yield(pick(pool, indices)) {
+				return
+			}
+
+			// Advance the rightmost position that has an unused larger index,
+			// then fill the remaining positions with the smallest unused ones.
+			pos := k - 1
+			for ; pos >= 0; pos-- {
+				used[indices[pos]] = false
+				next := indices[pos] + 1
+				for next < n && used[next] {
+					next++
+				}
+				if next < n {
+					indices[pos] = next
+					used[next] = true
+					break
+				}
+			}
+			if pos < 0 {
+				return
+			}
+
+			free := 0
+			for fill := pos + 1; fill < k; fill++ {
+				for used[free] {
+					free++
+				}
+				indices[fill] = free
+				used[free] = true
+			}
+		}
+	}
+}
+
+// PowerSet returns an iterator over every subset of the input elements,
+// ordered by size and then lexicographically by position, starting with
+// the empty subset. The input is buffered once.
+func PowerSet[T any](i It[T]) It[[]T] {
+	return func(yield func([]T) bool) {
+		buf := i.Collect()
+		for k := 0; k <= len(buf); k++ {
+			for subset := range Combinations(FromSlice(buf), k) {
+				if !yield(subset) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// pick returns a new slice with the pool elements at the given indices.
+func pick[T any](pool []T, indices []int) []T {
+	tuple := make([]T, len(indices))
+	for idx, p := range indices {
+		tuple[idx] = pool[p]
+	}
+	return tuple
+}
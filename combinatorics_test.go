@@ -0,0 +1,50 @@
+package steams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProduct(t *testing.T) {
+	result := Product(From(1, 2), From(3, 4), From(5)).Collect()
+	assert.Equal(t, [][]int{{1, 3, 5}, {1, 4, 5}, {2, 3, 5}, {2, 4, 5}}, result)
+
+	assert.Len(t, Product(From(1, 2), From[int]()).Collect(), 0)
+	assert.Len(t, Product[int]().Collect(), 0)
+	assert.Equal(t, [][]int{{1, 3}}, Product(From(1, 2), From(3, 4)).Take(1).Collect())
+}
+
+func TestCombinations(t *testing.T) {
+	result := Combinations(From("a", "b", "c", "d"), 2).Collect()
+	assert.Equal(t, [][]string{{"a", "b"}, {"a", "c"}, {"a", "d"}, {"b", "c"}, {"b", "d"}, {"c", "d"}}, result)
+
+	assert.Equal(t, [][]int{{}}, Combinations(From(1, 2), 0).Collect())
+	assert.Len(t, Combinations(From(1, 2), 3).Collect(), 0)
+	assert.Len(t, Combinations(From(1, 2, 3, 4, 5, 6), 3).Collect(), 20)
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	result := CombinationsWithReplacement(From(1, 2, 3), 2).Collect()
+	assert.Equal(t, [][]int{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}}, result)
+
+	assert.Len(t, CombinationsWithReplacement(From[int](), 2).Collect(), 0)
+	assert.Len(t, CombinationsWithReplacement(From(1, 2), 3).Collect(), 4)
+}
+
+func TestPermutations(t *testing.T) {
+	result := Permutations(From(1, 2, 3), 3).Collect()
+	assert.Equal(t, [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}, result)
+
+	result = Permutations(From(1, 2, 3), 2).Collect()
+	assert.Equal(t, [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}}, result)
+
+	assert.Len(t, Permutations(From(1, 2, 3, 4, 5), 3).Collect(), 60)
+	assert.Len(t, Permutations(From(1, 2), 3).Collect(), 0)
+}
+
+func TestPowerSet(t *testing.T) {
+	result := PowerSet(From(1, 2, 3)).Collect()
+	assert.Equal(t, [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}, result)
+	assert.Equal(t, [][]int{{}}, PowerSet(From[int]()).Collect())
+}
@@ -0,0 +1,292 @@
+package steams
+
+import (
+	"cmp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/javiorfo/nilo"
+)
+
+// Natural compares two Ordered values in ascending order. It returns a
+// negative value if a is less than b, zero if they are equal and a positive
+// value if a is greater than b, so it can be used with It.SortBy.
+func Natural[T Ordered](a, b T) int {
+	return cmp.Compare(a, b)
+}
+
+// NaturalStruct compares two OrderedStructs in ascending order using their
+// Compare method.
+func NaturalStruct[T OrderedStruct[T]](a, b T) int {
+	return a.Compare(b)
+}
+
+// Reversed returns a comparison function that orders elements in the
+// opposite order of cmp.
+func Reversed[T any](cmp func(T, T) int) func(T, T) int {
+	return func(a, b T) int {
+		return cmp(b, a)
+	}
+}
+
+// Comparing returns a comparison function that orders elements by the
+// natural order of the key extracted with keyFunc.
+func Comparing[T any, K Ordered](keyFunc func(T) K) func(T, T) int {
+	return ComparingBy(keyFunc, Natural[K])
+}
+
+// ComparingBy returns a comparison function that orders elements by the
+// key extracted with keyFunc, using cmp to compare the keys.
+func ComparingBy[T, K any](keyFunc func(T) K, cmp func(K, K) int) func(T, T) int {
+	return func(a, b T) int {
+		return cmp(keyFunc(a), keyFunc(b))
+	}
+}
+
+// ThenComparing returns a comparison function that orders elements by
+// first and, for elements first considers equal, by each of next in turn.
+func ThenComparing[T any](first func(T, T) int, next ...func(T, T) int) func(T, T) int {
+	return func(a, b T) int {
+		if c := first(a, b); c != 0 {
+			return c
+		}
+		for _, cmp := range next {
+			if c := cmp(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+}
+
+// NilsFirst returns a comparison function for Options that orders Nil
+// options before any value and compares values with cmp.
+func NilsFirst[T any](cmp func(T, T) int) func(nilo.Option[T], nilo.Option[T]) int {
+	return compareOptions(cmp, -1)
+}
+
+// NilsLast returns a comparison function for Options that orders Nil
+// options after any value and compares values with cmp.
+func NilsLast[T any](cmp func(T, T) int) func(nilo.Option[T], nilo.Option[T]) int {
+	return compareOptions(cmp, 1)
+}
+
+// compareOptions compares two Options, returning nilOrder when only the
+// first one is Nil.
+func compareOptions[T any](cmp func(T, T) int, nilOrder int) func(nilo.Option[T], nilo.Option[T]) int {
+	return func(a, b nilo.Option[T]) int {
+		switch {
+		case a.IsNil() && b.IsNil():
+			return 0
+		case a.IsNil():
+			return nilOrder
+		case b.IsNil():
+			return -nilOrder
+		default:
+			return cmp(a.AsValue(), b.AsValue())
+		}
+	}
+}
+
+// LessToCmp turns a less function, like the ones taken by It2.SortBy or
+// returned by Min, into a three-way comparison function.
+func LessToCmp[T any](less func(T, T) bool) func(T, T) int {
+	return func(a, b T) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+// CmpToLess turns a three-way comparison function into a less function,
+// suitable for It2.SortBy, It.Compare or It2.Compare. With It.Compare,
+// CmpToLess(cmp) selects the minimum and CmpToLess(Reversed(cmp)) the
+// maximum.
+func CmpToLess[T any](cmp func(T, T) int) func(T, T) bool {
+	return func(a, b T) bool {
+		return cmp(a, b) < 0
+	}
+}
+
+// NaturalOrder compares two strings treating every run of digits as a
+// number, so "file2" sorts before "file10". Other characters are compared
+// byte by byte. Runs with the same numeric value but different leading
+// zeros, such as "01" and "1", are considered equal.
+func NaturalOrder(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if isDigit(a[i]) && isDigit(b[j]) {
+			startA, startB := i, j
+			for i < len(a) && isDigit(a[i]) {
+				i++
+			}
+			for j < len(b) && isDigit(b[j]) {
+				j++
+			}
+			if c := compareDigits(a[startA:i], b[startB:j]); c != 0 {
+				return c
+			}
+			continue
+		}
+
+		// A digit compared against any other byte orders the same way as
+		// its whole run would, since non-digits sit outside '0'..'9'.
+		if a[i] != b[j] {
+			return cmp.Compare(a[i], b[j])
+		}
+		i++
+		j++
+	}
+	return cmp.Compare(len(a)-i, len(b)-j)
+}
+
+// CaseInsensitive compares two strings ignoring letter case. Strings that
+// only differ in case are considered equal.
+func CaseInsensitive(a, b string) int {
+	for a != "" && b != "" {
+		ra, sizeA := utf8.DecodeRuneInString(a)
+		rb, sizeB := utf8.DecodeRuneInString(b)
+		if c := cmp.Compare(unicode.ToLower(ra), unicode.ToLower(rb)); c != 0 {
+			return c
+		}
+		a, b = a[sizeA:], b[sizeB:]
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+// ByLength compares two strings by their length in runes. Strings of the
+// same length are considered equal; combine it with ThenComparing to break
+// ties.
+func ByLength(a, b string) int {
+	return cmp.Compare(utf8.RuneCountInString(a), utf8.RuneCountInString(b))
+}
+
+// SemverOrder compares two version strings following the Semantic
+// Versioning 2.0.0 precedence rules. A leading "v" is allowed, missing
+// minor or patch numbers count as zero and build metadata is ignored.
+// Strings that are not valid versions sort after every valid version and
+// are compared with each other as plain strings.
+func SemverOrder(a, b string) int {
+	va, okA := parseSemver(a)
+	vb, okB := parseSemver(b)
+	switch {
+	case !okA && !okB:
+		return strings.Compare(a, b)
+	case !okA:
+		return 1
+	case !okB:
+		return -1
+	}
+
+	for idx := range va.core {
+		if c := compareDigits(va.core[idx], vb.core[idx]); c != 0 {
+			return c
+		}
+	}
+
+	// A version without pre-release has higher precedence than one with it.
+	switch {
+	case len(va.pre) == 0 && len(vb.pre) == 0:
+		return 0
+	case len(va.pre) == 0:
+		return 1
+	case len(vb.pre) == 0:
+		return -1
+	}
+
+	for idx := 0; idx < len(va.pre) && idx < len(vb.pre); idx++ {
+		if c := comparePreRelease(va.pre[idx], vb.pre[idx]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(va.pre), len(vb.pre))
+}
+
+// semver holds the numeric core and the pre-release identifiers of a version.
+type semver struct {
+	core [3]string
+	pre  []string
+}
+
+// parseSemver splits a version string into its parts, reporting whether
+// it is a valid version.
+func parseSemver(s string) (semver, bool) {
+	var v semver
+	s = strings.TrimPrefix(s, "v")
+	s, _, _ = strings.Cut(s, "+")
+	s, pre, hasPre := strings.Cut(s, "-")
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return v, false
+	}
+	for idx := range v.core {
+		v.core[idx] = "0"
+		if idx < len(parts) {
+			if !isNumber(parts[idx]) {
+				return v, false
+			}
+			v.core[idx] = parts[idx]
+		}
+	}
+
+	if hasPre {
+		v.pre = strings.Split(pre, ".")
+		for _, id := range v.pre {
+			if id == "" {
+				return v, false
+			}
+		}
+	}
+	return v, true
+}
+
+// comparePreRelease compares two pre-release identifiers: numeric ones
+// numerically, alphanumeric ones lexically, and numeric before alphanumeric.
+func comparePreRelease(a, b string) int {
+	numA, numB := isNumber(a), isNumber(b)
+	switch {
+	case numA && numB:
+		return compareDigits(a, b)
+	case numA:
+		return -1
+	case numB:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// compareDigits compares two runs of decimal digits by numeric value,
+// without limits on their length.
+func compareDigits(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if c := cmp.Compare(len(a), len(b)); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+// isNumber reports whether s is a non-empty run of decimal digits.
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for idx := range len(s) {
+		if !isDigit(s[idx]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,177 @@
+package steams
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/javiorfo/nilo"
+	"github.com/stretchr/testify/assert"
+)
+
+type version struct {
+	Major, Minor int
+}
+
+func (v version) Compare(other version) int {
+	return ThenComparing(Comparing(func(v version) int { return v.Major }), Comparing(func(v version) int { return v.Minor }))(v, other)
+}
+
+func TestNatural(t *testing.T) {
+	assert.Equal(t, -1, Natural(1, 2))
+	assert.Equal(t, 0, Natural("a", "a"))
+	assert.Equal(t, 1, Natural(2.5, 1.0))
+	assert.Equal(t, -1, Natural(math.NaN(), 1.0))
+
+	assert.Equal(t, []int{1, 2, 3}, From(3, 1, 2).SortBy(Natural[int]).Collect())
+	assert.Equal(t, []int{3, 2, 1}, From(3, 1, 2).SortBy(Reversed(Natural[int])).Collect())
+
+	s := []int{5, 2, 8, 1}
+	slices.SortFunc(s, Natural[int])
+	assert.Equal(t, []int{1, 2, 5, 8}, s)
+}
+
+func TestNaturalStruct(t *testing.T) {
+	versions := From(version{1, 2}, version{0, 9}, version{1, 0})
+	assert.Equal(t, []version{{0, 9}, {1, 0}, {1, 2}}, versions.SortBy(NaturalStruct[version]).Collect())
+}
+
+func TestComparing(t *testing.T) {
+	people := From(setItem{3, "carl"}, setItem{1, "ann"}, setItem{2, "ann"})
+
+	byName := Comparing(func(s setItem) string { return s.Name })
+	byID := Comparing(func(s setItem) int { return s.ID })
+	sorted := people.SortBy(ThenComparing(byName, Reversed(byID))).Collect()
+	assert.Equal(t, []setItem{{2, "ann"}, {1, "ann"}, {3, "carl"}}, sorted)
+
+	byNameLen := ComparingBy(func(s setItem) string { return s.Name }, func(a, b string) int { return len(a) - len(b) })
+	assert.Equal(t, 0, byNameLen(setItem{1, "ann"}, setItem{2, "bob"}))
+	assert.Equal(t, 0, ThenComparing(byNameLen)(setItem{1, "ann"}, setItem{2, "bob"}))
+}
+
+func TestNilsFirstLast(t *testing.T) {
+	options := []nilo.Option[int]{nilo.Value(2), nilo.Nil[int](), nilo.Value(1)}
+
+	first := FromSlice(options).SortBy(NilsFirst(Natural[int])).Collect()
+	assert.Equal(t, []nilo.Option[int]{nilo.Nil[int](), nilo.Value(1), nilo.Value(2)}, first)
+
+	last := FromSlice(options).SortBy(NilsLast(Natural[int])).Collect()
+	assert.Equal(t, []nilo.Option[int]{nilo.Value(1), nilo.Value(2), nilo.Nil[int]()}, last)
+
+	assert.Equal(t, 0, NilsLast(Natural[int])(nilo.Nil[int](), nilo.Nil[int]()))
+}
+
+func TestLessCmpAdapters(t *testing.T) {
+	cmp := LessToCmp(Min[int])
+	assert.Equal(t, -1, cmp(1, 2))
+	assert.Equal(t, 1, cmp(2, 1))
+	assert.Equal(t, 0, cmp(2, 2))
+
+	assert.Equal(t, nilo.Value(1), From(3, 1, 2).Compare(CmpToLess(Natural[int])))
+	assert.Equal(t, nilo.Value(3), From(3, 1, 2).Compare(CmpToLess(Reversed(Natural[int]))))
+
+	m := FromMap(map[string]int{"b": 1, "a": 2}).SortBy(CmpToLess(Natural[string]))
+	assert.Equal(t, []string{"a", "b"}, m.Keys().Collect())
+}
+
+func TestNaturalOrder(t *testing.T) {
+	files := From("file10.txt", "file2.txt", "file1.txt", "File3.txt", "file02b.txt", "file")
+	sorted := files.SortBy(NaturalOrder).Collect()
+	assert.Equal(t, []string{"File3.txt", "file", "file1.txt", "file2.txt", "file02b.txt", "file10.txt"}, sorted)
+
+	assert.Equal(t, 0, NaturalOrder("a01", "a1"))
+	assert.Equal(t, -1, NaturalOrder("a99", "a100"))
+	assert.Equal(t, 1, NaturalOrder("123456789012345678901234567890", "99"))
+
+	m := FromMap(map[string]int{"v10": 10, "v9": 9}).SortBy(CmpToLess(NaturalOrder))
+	assert.Equal(t, []string{"v9", "v10"}, m.Keys().Collect())
+}
+
+func TestCaseInsensitive(t *testing.T) {
+	assert.Equal(t, []string{"apple", "Banana", "cherry"}, From("cherry", "Banana", "apple").SortBy(CaseInsensitive).Collect())
+	assert.Equal(t, 0, CaseInsensitive("GoLang", "golang"))
+	assert.Equal(t, -1, CaseInsensitive("Go", "golang"))
+	assert.Equal(t, 0, CaseInsensitive("ÉCOLE", "école"))
+}
+
+func TestByLength(t *testing.T) {
+	assert.Equal(t, []string{"b", "ñu", "cc", "aaa"}, From("aaa", "b", "ñu", "cc").SortStableBy(ByLength).Collect())
+	sorted := From("bb", "aa", "c").SortBy(ThenComparing(ByLength, Natural[string])).Collect()
+	assert.Equal(t, []string{"c", "aa", "bb"}, sorted)
+}
+
+func TestSemverOrder(t *testing.T) {
+	versions := From("1.0.0", "1.0.0-alpha.1", "1.0.0-beta", "1.0.0-alpha", "1.0.0-rc.1",
+		"1.0.0-alpha.beta", "1.0.0-beta.11", "1.0.0-beta.2", "v0.9", "not-a-version", "10.0.0", "2.0.0")
+	sorted := versions.SortBy(SemverOrder).Collect()
+	assert.Equal(t, []string{"v0.9", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta",
+		"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "2.0.0", "10.0.0", "not-a-version"}, sorted)
+
+	assert.Equal(t, 0, SemverOrder("1.2.3+build.5", "v1.2.3"))
+	assert.Equal(t, 0, SemverOrder("1.2", "1.2.0"))
+	assert.Equal(t, 1, SemverOrder("1.2.3.4", "1.2.3"))
+	assert.Equal(t, 1, SemverOrder("1.0.0-", "1.0.0"))
+}
+
+// checkComparator verifies that cmp behaves as a consistent ordering for
+// the given values: antisymmetric and transitive.
+func checkComparator(t *testing.T, cmp func(string, string) int, a, b, c string) {
+	sign := func(x int) int { return Natural(x, 0) }
+
+	for _, p := range [][2]string{{a, b}, {b, c}, {a, c}, {a, a}} {
+		if sign(cmp(p[0], p[1])) != -sign(cmp(p[1], p[0])) {
+			t.Fatalf("not antisymmetric for %q and %q", p[0], p[1])
+		}
+	}
+
+	values := []string{a, b, c}
+	for _, x := range values {
+		for _, y := range values {
+			for _, z := range values {
+				if cmp(x, y) <= 0 && cmp(y, z) <= 0 && cmp(x, z) > 0 {
+					t.Fatalf("not transitive for %q <= %q <= %q", x, y, z)
+				}
+				if cmp(x, y) == 0 && cmp(y, z) == 0 && cmp(x, z) != 0 {
+					t.Fatalf("equality not transitive for %q, %q, %q", x, y, z)
+				}
+			}
+		}
+	}
+}
+
+func fuzzComparator(f *testing.F, cmp func(string, string) int, seeds ...[3]string) {
+	for _, s := range seeds {
+		f.Add(s[0], s[1], s[2])
+	}
+	f.Fuzz(func(t *testing.T, a, b, c string) {
+		if !utf8.ValidString(a) || !utf8.ValidString(b) || !utf8.ValidString(c) {
+			t.Skip()
+		}
+		checkComparator(t, cmp, a, b, c)
+	})
+}
+
+func FuzzNaturalOrder(f *testing.F) {
+	fuzzComparator(f, NaturalOrder,
+		[3]string{"file2", "file10", "file010"},
+		[3]string{"a1b", "a01b", "a1"},
+		[3]string{"9", "a", "/"})
+}
+
+func FuzzCaseInsensitive(f *testing.F) {
+	fuzzComparator(f, CaseInsensitive,
+		[3]string{"Go", "go", "GO!"},
+		[3]string{"ß", "SS", "ss"})
+}
+
+func FuzzByLength(f *testing.F) {
+	fuzzComparator(f, ByLength, [3]string{"a", "ñ", "ab"})
+}
+
+func FuzzSemverOrder(f *testing.F) {
+	fuzzComparator(f, SemverOrder,
+		[3]string{"1.0.0-alpha", "1.0.0-1", "1.0.0"},
+		[3]string{"v1.2", "1.2.0+meta", "bogus"},
+		[3]string{"1.0.0-a.1", "1.0.0-a.b", "1.0.0-a"})
+}
@@ -0,0 +1,123 @@
+package steams
+
+import "iter"
+
+// Equal reports whether both iterators yield the same elements in the
+// same order. It short-circuits on the first difference.
+func Equal[T comparable](i1, i2 It[T]) bool {
+	return EqualFunc(i1, i2, func(a, b T) bool { return a == b })
+}
+
+// EqualFunc is an Equal variant that compares elements with eq.
+func EqualFunc[T, R any](i1 It[T], i2 It[R], eq func(T, R) bool) bool {
+	next1, stop1 := iter.Pull(iter.Seq[T](i1))
+	defer stop1()
+	next2, stop2 := iter.Pull(iter.Seq[R](i2))
+	defer stop2()
+
+	for {
+		val1, ok1 := next1()
+		val2, ok2 := next2()
+		if !ok1 || !ok2 {
+			return ok1 == ok2
+		}
+		if !eq(val1, val2) {
+			return false
+		}
+	}
+}
+
+// CompareSeq compares two iterators lexicographically using cmp. It returns
+// the result of the first non-zero comparison, or, if one iterator is a
+// prefix of the other, -1 when i1 is shorter, +1 when i2 is shorter and 0
+// when both have the same length.
+func CompareSeq[T any](i1, i2 It[T], cmp func(T, T) int) int {
+	next1, stop1 := iter.Pull(iter.Seq[T](i1))
+	defer stop1()
+	next2, stop2 := iter.Pull(iter.Seq[T](i2))
+	defer stop2()
+
+	for {
+		val1, ok1 := next1()
+		val2, ok2 := next2()
+		switch {
+		case !ok1 && !ok2:
+			return 0
+		case !ok1:
+			return -1
+		case !ok2:
+			return 1
+		}
+		if c := cmp(val1, val2); c != 0 {
+			return c
+		}
+	}
+}
+
+// StartsWith reports whether the iterator begins with the elements of prefix.
+// Only as many elements as prefix holds are consumed.
+func StartsWith[T comparable](i It[T], prefix It[T]) bool {
+	next, stop := iter.Pull(iter.Seq[T](i))
+	defer stop()
+
+	for p := range prefix {
+		v, ok := next()
+		if !ok || v != p {
+			return false
+		}
+	}
+	return true
+}
+
+// EndsWith reports whether the iterator ends with the elements of suffix.
+// The suffix is collected and the iterator is consumed keeping only the
+// last len(suffix) elements in memory.
+func EndsWith[T comparable](i It[T], suffix It[T]) bool {
+	want := suffix.Collect()
+	if len(want) == 0 {
+		return true
+	}
+
+	window := newRing[T](len(want))
+	for v := range i {
+		window.push(v)
+	}
+	if window.count < len(want) {
+		return false
+	}
+
+	// Once full, the oldest element sits at the ring head.
+	for idx, w := range want {
+		if window.buf[(window.head+idx)%len(want)] != w {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal2 reports whether both key-value iterators yield the same pairs in
+// the same order. It short-circuits on the first difference.
+func Equal2[K, V comparable](i1, i2 It2[K, V]) bool {
+	return EqualFunc2(i1, i2, func(a, b Entry[K, V]) bool { return a == b })
+}
+
+// EqualFunc2 is an Equal2 variant that compares entries with eq.
+func EqualFunc2[K comparable, V any](i1, i2 It2[K, V], eq func(Entry[K, V], Entry[K, V]) bool) bool {
+	return EqualFunc(CollectIt2ToIt(i1, newEntry[K, V]), CollectIt2ToIt(i2, newEntry[K, V]), eq)
+}
+
+// CompareSeq2 compares two key-value iterators lexicographically, entry
+// by entry, with the same rules as CompareSeq.
+func CompareSeq2[K comparable, V any](i1, i2 It2[K, V], cmp func(Entry[K, V], Entry[K, V]) int) int {
+	return CompareSeq(CollectIt2ToIt(i1, newEntry[K, V]), CollectIt2ToIt(i2, newEntry[K, V]), cmp)
+}
+
+// StartsWith2 reports whether the key-value iterator begins with the pairs of prefix.
+func StartsWith2[K, V comparable](i It2[K, V], prefix It2[K, V]) bool {
+	return StartsWith(CollectIt2ToIt(i, newEntry[K, V]), CollectIt2ToIt(prefix, newEntry[K, V]))
+}
+
+// EndsWith2 reports whether the key-value iterator ends with the pairs of suffix.
+func EndsWith2[K, V comparable](i It2[K, V], suffix It2[K, V]) bool {
+	return EndsWith(CollectIt2ToIt(i, newEntry[K, V]), CollectIt2ToIt(suffix, newEntry[K, V]))
+}
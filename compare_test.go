@@ -0,0 +1,76 @@
+package steams
+
+import (
+	"cmp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+	assert.True(t, Equal(From(1, 2, 3), From(1, 2, 3)))
+	assert.False(t, Equal(From(1, 2, 3), From(1, 2)))
+	assert.False(t, Equal(From(1, 2), From(1, 2, 3)))
+	assert.False(t, Equal(From(1, 2, 3), From(1, 5, 3)))
+	assert.True(t, Equal(From[int](), From[int]()))
+
+	pulled := 0
+	counted := It[int](func(yield func(int) bool) {
+		for _, v := range []int{1, 9, 3, 4} {
+			pulled++
+			if !yield(v) {
+				return
+			}
+		}
+	})
+	assert.False(t, Equal(From(1, 2, 3, 4), counted))
+	assert.Equal(t, 2, pulled, "Equal should short-circuit")
+}
+
+func TestEqualFunc(t *testing.T) {
+	assert.True(t, EqualFunc(From("a", "B"), From("A", "b"), strings.EqualFold))
+	assert.True(t, EqualFunc(From(1, 2), From("1", "2"), func(i int, s string) bool { return string(rune('0'+i)) == s }))
+}
+
+func TestCompareSeq(t *testing.T) {
+	assert.Equal(t, 0, CompareSeq(From(1, 2), From(1, 2), cmp.Compare[int]))
+	assert.Equal(t, -1, CompareSeq(From(1, 2), From(1, 3), cmp.Compare[int]))
+	assert.Equal(t, 1, CompareSeq(From(2), From(1, 3), cmp.Compare[int]))
+	assert.Equal(t, -1, CompareSeq(From(1), From(1, 3), cmp.Compare[int]))
+	assert.Equal(t, 1, CompareSeq(From(1, 3), From(1), cmp.Compare[int]))
+}
+
+func TestStartsWith(t *testing.T) {
+	assert.True(t, StartsWith(From(1, 2, 3), From(1, 2)))
+	assert.True(t, StartsWith(From(1, 2, 3), From[int]()))
+	assert.False(t, StartsWith(From(1, 2, 3), From(2)))
+	assert.False(t, StartsWith(From(1), From(1, 2)))
+	assert.True(t, StartsWith(Repeat(7), From(7, 7)), "only the prefix length should be consumed")
+}
+
+func TestEndsWith(t *testing.T) {
+	assert.True(t, EndsWith(From(1, 2, 3), From(2, 3)))
+	assert.True(t, EndsWith(From(1, 2, 3), From(1, 2, 3)))
+	assert.True(t, EndsWith(From(1, 2, 3), From[int]()))
+	assert.False(t, EndsWith(From(1, 2, 3), From(1, 2)))
+	assert.False(t, EndsWith(From(3), From(2, 3)))
+}
+
+func TestIt2Comparisons(t *testing.T) {
+	a := ZipToIt2(From("a", "b", "c"), From(1, 2, 3))
+	b := ZipToIt2(From("a", "b", "c"), From(1, 2, 3))
+	c := ZipToIt2(From("a", "b", "c"), From(1, 5, 3))
+
+	assert.True(t, Equal2(a, b))
+	assert.False(t, Equal2(a, c))
+	assert.True(t, EqualFunc2(a, c, func(x, y Entry[string, int]) bool { return x.Key == y.Key }))
+
+	byValue := func(x, y Entry[string, int]) int { return cmp.Compare(x.Value, y.Value) }
+	assert.Equal(t, -1, CompareSeq2(a, c, byValue))
+	assert.Equal(t, 0, CompareSeq2(a, b, byValue))
+
+	assert.True(t, StartsWith2(a, ZipToIt2(From("a"), From(1))))
+	assert.False(t, StartsWith2(a, ZipToIt2(From("a"), From(2))))
+	assert.True(t, EndsWith2(c, ZipToIt2(From("b", "c"), From(5, 3))))
+}
@@ -0,0 +1,98 @@
+package steams
+
+import (
+	"iter"
+
+	"github.com/javiorfo/nilo"
+)
+
+// Cursor is a pull-based wrapper around an It that supports lookahead and
+// pushing elements back, which is handy for tokenizers and record parsers.
+// The underlying iterator is only advanced on demand. Call Close when the
+// cursor is no longer needed to release the underlying iterator.
+// A Cursor is not safe for concurrent use.
+type Cursor[T any] struct {
+	next   func() (T, bool)
+	stop   func()
+	buf    []T
+	closed bool
+}
+
+// NewCursor creates a Cursor positioned before the first element of the iterator.
+func NewCursor[T any](i It[T]) *Cursor[T] {
+	next, stop := iter.Pull(iter.Seq[T](i))
+	return &Cursor[T]{next: next, stop: stop}
+}
+
+// Next advances the cursor and returns the next element, or a Nil option
+// if there are no more elements.
+func (c *Cursor[T]) Next() nilo.Option[T] {
+	if !c.fill(1) {
+		return nilo.Nil[T]()
+	}
+	v := c.buf[0]
+	c.buf = c.buf[1:]
+	return nilo.Value(v)
+}
+
+// Peek returns the next element without advancing the cursor, or a Nil
+// option if there are no more elements.
+func (c *Cursor[T]) Peek() nilo.Option[T] {
+	if !c.fill(1) {
+		return nilo.Nil[T]()
+	}
+	return nilo.Value(c.buf[0])
+}
+
+// PeekN returns up to the next n elements without advancing the cursor.
+// The result is shorter than n if the cursor runs out of elements.
+func (c *Cursor[T]) PeekN(n int) []T {
+	if n <= 0 {
+		return nil
+	}
+	c.fill(n)
+	return append([]T(nil), c.buf[:min(n, len(c.buf))]...)
+}
+
+// PutBack pushes v to the front of the cursor, so the next call to Next
+// or Peek returns it.
+func (c *Cursor[T]) PutBack(v T) {
+	c.buf = append([]T{v}, c.buf...)
+}
+
+// Close releases the underlying iterator. Elements already buffered by
+// Peek, PeekN or PutBack remain available; nothing more is pulled.
+func (c *Cursor[T]) Close() {
+	if !c.closed {
+		c.closed = true
+		c.stop()
+	}
+}
+
+// Rest returns an iterator over the remaining elements of the cursor.
+// Elements yielded by it are consumed from the cursor, so if iteration
+// stops early the cursor can keep being used from that point.
+func (c *Cursor[T]) Rest() It[T] {
+	return func(yield func(T) bool) {
+		for {
+			v := c.Next()
+			if v.IsNil() || !yield(v.AsValue()) {
+				return
+			}
+		}
+	}
+}
+
+// fill pulls elements until at least n are buffered, reporting whether it
+// succeeded. The underlying iterator is released once it is exhausted.
+func (c *Cursor[T]) fill(n int) bool {
+	for len(c.buf) < n && !c.closed {
+		v, ok := c.next()
+		if !ok {
+			c.Close()
+			break
+		}
+		c.buf = append(c.buf, v)
+	}
+	return len(c.buf) >= n
+}
@@ -0,0 +1,86 @@
+package steams
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/javiorfo/nilo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorNextPeek(t *testing.T) {
+	c := NewCursor(From(1, 2, 3))
+	defer c.Close()
+
+	assert.Equal(t, nilo.Value(1), c.Peek())
+	assert.Equal(t, nilo.Value(1), c.Next())
+	assert.Equal(t, []int{2, 3}, c.PeekN(5))
+	assert.Equal(t, nilo.Value(2), c.Next())
+	assert.Equal(t, nilo.Value(3), c.Next())
+	assert.True(t, c.Next().IsNil())
+	assert.True(t, c.Peek().IsNil())
+}
+
+func TestCursorPutBack(t *testing.T) {
+	c := NewCursor(From("b", "c"))
+	defer c.Close()
+
+	c.PutBack("a")
+	assert.Equal(t, []string{"a", "b"}, c.PeekN(2))
+	assert.Equal(t, nilo.Value("a"), c.Next())
+
+	v := c.Next().AsValue()
+	c.PutBack(v)
+	assert.Equal(t, []string{"b", "c"}, c.Rest().Collect())
+}
+
+func TestCursorRest(t *testing.T) {
+	c := NewCursor(From(1, 2, 3, 4, 5))
+	defer c.Close()
+
+	assert.Equal(t, nilo.Value(1), c.Next())
+	assert.Equal(t, []int{2, 3}, c.Rest().Take(2).Collect())
+	assert.Equal(t, nilo.Value(4), c.Peek())
+	assert.Equal(t, []int{4, 5}, c.Rest().Collect())
+}
+
+func TestCursorClose(t *testing.T) {
+	pulled := 0
+	source := It[int](func(yield func(int) bool) {
+		for i := range 10 {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	c := NewCursor(source)
+	c.PeekN(2)
+	c.Close()
+	c.Close()
+
+	assert.Equal(t, []int{0, 1}, c.Rest().Collect())
+	assert.Equal(t, 2, pulled)
+}
+
+func TestCursorTokenizer(t *testing.T) {
+	input := "ab 12 c3"
+	c := NewCursor(FromSlice([]rune(input)))
+	defer c.Close()
+
+	var tokens []string
+	for c.Peek().IsValue() {
+		r := c.Next().AsValue()
+		if unicode.IsSpace(r) {
+			continue
+		}
+		token := []rune{r}
+		for c.Peek().IsValueAnd(func(n rune) bool { return unicode.IsDigit(n) == unicode.IsDigit(r) && !unicode.IsSpace(n) }) {
+			token = append(token, c.Next().AsValue())
+		}
+		tokens = append(tokens, string(token))
+	}
+
+	assert.Equal(t, []string{"ab", "12", "c", "3"}, tokens)
+}
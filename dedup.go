@@ -0,0 +1,86 @@
+package steams
+
+import "container/list"
+
+// DistinctBy returns an iterator that yields only the first element for
+// each key produced by keyFunc. It uses a map to track seen keys, which
+// requires O(N) memory.
+func DistinctBy[T any, K comparable](i It[T], keyFunc func(T) K) It[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[K]struct{})
+		for v := range i {
+			key := keyFunc(v)
+			if _, exists := seen[key]; !exists {
+				seen[key] = struct{}{}
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// DistinctUntilChanged returns an iterator that drops elements equal to
+// the element immediately before them. Non-adjacent duplicates are kept.
+// It requires O(1) memory.
+func DistinctUntilChanged[T comparable](i It[T]) It[T] {
+	return DedupConsecutive(i, func(a, b T) bool { return a == b })
+}
+
+// DedupConsecutive is a DistinctUntilChanged variant that uses eq to
+// decide whether two adjacent elements are duplicates.
+func DedupConsecutive[T any](i It[T], eq func(T, T) bool) It[T] {
+	return func(yield func(T) bool) {
+		var prev T
+		first := true
+		for v := range i {
+			if !first && eq(prev, v) {
+				continue
+			}
+			first = false
+			prev = v
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// DistinctWindow returns an iterator that drops elements whose key was
+// seen among the most recently used capacity keys. Older keys are evicted
+// in least-recently-used order, so memory stays bounded by capacity.
+// A duplicate refreshes its key's position in the window.
+// If capacity is not positive, every element is yielded.
+func DistinctWindow[T any, K comparable](i It[T], keyFunc func(T) K, capacity int) It[T] {
+	return func(yield func(T) bool) {
+		if capacity <= 0 {
+			for v := range i {
+				if !yield(v) {
+					return
+				}
+			}
+			return
+		}
+
+		order := list.New()
+		seen := make(map[K]*list.Element, capacity)
+		for v := range i {
+			key := keyFunc(v)
+			if elem, exists := seen[key]; exists {
+				order.MoveToFront(elem)
+				continue
+			}
+
+			seen[key] = order.PushFront(key)
+			if order.Len() > capacity {
+				oldest := order.Back()
+				order.Remove(oldest)
+				delete(seen, oldest.Value.(K))
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package steams
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistinctBy(t *testing.T) {
+	words := From("apple", "Avocado", "banana", "blueberry", "cherry")
+	result := DistinctBy(words, func(s string) string { return strings.ToLower(s[:1]) })
+	assert.Equal(t, []string{"apple", "banana", "cherry"}, result.Collect())
+	assert.Equal(t, []string{"apple"}, result.Take(1).Collect())
+}
+
+func TestDistinctUntilChanged(t *testing.T) {
+	result := DistinctUntilChanged(From(1, 1, 2, 2, 2, 1, 3, 3))
+	assert.Equal(t, []int{1, 2, 1, 3}, result.Collect())
+
+	var empty []int
+	assert.Equal(t, empty, DistinctUntilChanged(From[int]()).Collect())
+}
+
+func TestDedupConsecutive(t *testing.T) {
+	result := DedupConsecutive(From("a", "A", "b", "B", "a"), strings.EqualFold)
+	assert.Equal(t, []string{"a", "b", "a"}, result.Collect())
+}
+
+func TestDistinctWindow(t *testing.T) {
+	id := func(i int) int { return i }
+
+	result := DistinctWindow(From(1, 2, 1, 3, 4, 1, 2), id, 2)
+	assert.Equal(t, []int{1, 2, 3, 4, 1, 2}, result.Collect())
+
+	// A repeated key is refreshed, so it stays in the window.
+	result = DistinctWindow(From(1, 2, 1, 3, 1, 4, 1), id, 2)
+	assert.Equal(t, []int{1, 2, 3, 4}, result.Collect())
+
+	assert.Equal(t, []int{1, 1}, DistinctWindow(From(1, 1), id, 0).Collect())
+}
@@ -0,0 +1,224 @@
+package steams
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// EditKind identifies the operation of an Edit in an edit script.
+type EditKind int
+
+const (
+	// EditEqual means the element is present, unchanged, in both sequences.
+	EditEqual EditKind = iota
+	// EditInsert means the element is only present in the new sequence.
+	EditInsert
+	// EditDelete means the element is only present in the old sequence.
+	EditDelete
+	// EditModify means the element is present in both sequences with the
+	// same key but a different content. Only DiffBy reports it.
+	EditModify
+)
+
+// String returns the name of the edit kind.
+func (k EditKind) String() string {
+	switch k {
+	case EditEqual:
+		return "Equal"
+	case EditInsert:
+		return "Insert"
+	case EditDelete:
+		return "Delete"
+	case EditModify:
+		return "Modify"
+	default:
+		return fmt.Sprintf("EditKind(%d)", int(k))
+	}
+}
+
+// Edit is a single operation of an edit script turning an old sequence
+// into a new one. OldIndex is -1 for insertions and NewIndex is -1 for
+// deletions; the corresponding Old or New field then holds the zero value.
+type Edit[T any] struct {
+	Kind     EditKind
+	OldIndex int
+	NewIndex int
+	Old      T
+	New      T
+}
+
+// DiffSeq computes the shortest edit script that turns old into new using
+// the Myers diff algorithm, comparing elements with eq. It yields Equal,
+// Delete and Insert edits in sequence order.
+// Note: both iterators are collected into memory before the first edit is
+// yielded, and the algorithm runs in O((N+M)D) time for D differences.
+func DiffSeq[T any](old, new It[T], eq func(T, T) bool) It[Edit[T]] {
+	return func(yield func(Edit[T]) bool) {
+		for _, e := range myersDiff(old.Collect(), new.Collect(), eq) {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// DiffBy is a DiffSeq variant that matches elements by the key returned
+// from keyFunc. Matched elements are reported as Equal when eq returns
+// true for them and as Modify otherwise.
+func DiffBy[T any, K comparable](old, new It[T], keyFunc func(T) K, eq func(T, T) bool) It[Edit[T]] {
+	return func(yield func(Edit[T]) bool) {
+		sameKey := func(a, b T) bool { return keyFunc(a) == keyFunc(b) }
+		for _, e := range myersDiff(old.Collect(), new.Collect(), sameKey) {
+			if e.Kind == EditEqual && !eq(e.Old, e.New) {
+				e.Kind = EditModify
+			}
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// myersDiff returns the shortest edit script between a and b.
+func myersDiff[T any](a, b []T, eq func(T, T) bool) []Edit[T] {
+	n, m := len(a), len(b)
+	offset := n + m
+	v := make([]int, 2*offset+2)
+
+	// trace keeps the furthest reaching x of every diagonal before each
+	// step, so the path can be recovered backwards.
+	var trace [][]int
+search:
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, slices.Clone(v))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && eq(a[x], b[y]) {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	var script []Edit[T]
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		prevK := k - 1
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			script = append(script, Edit[T]{Kind: EditEqual, OldIndex: x, NewIndex: y, Old: a[x], New: b[y]})
+		}
+		if d > 0 {
+			if x == prevX {
+				script = append(script, Edit[T]{Kind: EditInsert, OldIndex: -1, NewIndex: prevY, New: b[prevY]})
+			} else {
+				script = append(script, Edit[T]{Kind: EditDelete, OldIndex: prevX, NewIndex: -1, Old: a[prevX]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	slices.Reverse(script)
+	return script
+}
+
+// UnifiedDiff renders the differences between two sequences of lines in
+// the unified diff format, with the given number of context lines around
+// each change. It returns an empty string if both sequences are equal.
+func UnifiedDiff(old, new It[string], oldName, newName string, context int) string {
+	script := myersDiff(old.Collect(), new.Collect(), func(a, b string) bool { return a == b })
+	context = max(context, 0)
+
+	// Line counters before each edit, used to number the hunks.
+	oldPos := make([]int, len(script)+1)
+	newPos := make([]int, len(script)+1)
+	for idx, e := range script {
+		oldPos[idx+1], newPos[idx+1] = oldPos[idx], newPos[idx]
+		if e.Kind != EditInsert {
+			oldPos[idx+1]++
+		}
+		if e.Kind != EditDelete {
+			newPos[idx+1]++
+		}
+	}
+
+	var sb strings.Builder
+	for idx := 0; idx < len(script); {
+		if script[idx].Kind == EditEqual {
+			idx++
+			continue
+		}
+
+		// Extend the hunk while the next change is close enough for the
+		// context lines of both to overlap.
+		start := max(idx-context, 0)
+		end := idx
+		for end < len(script) {
+			if script[end].Kind != EditEqual {
+				end++
+				continue
+			}
+			next := end
+			for next < len(script) && script[next].Kind == EditEqual {
+				next++
+			}
+			if next == len(script) || next-end > 2*context {
+				end = min(end+context, len(script))
+				break
+			}
+			end = next
+		}
+
+		if sb.Len() == 0 {
+			fmt.Fprintf(&sb, "--- %s\n+++ %s\n", oldName, newName)
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n",
+			hunkRange(oldPos[start], oldPos[end]-oldPos[start]),
+			hunkRange(newPos[start], newPos[end]-newPos[start]))
+		for _, e := range script[start:end] {
+			switch e.Kind {
+			case EditEqual:
+				sb.WriteString(" " + e.Old + "\n")
+			case EditDelete:
+				sb.WriteString("-" + e.Old + "\n")
+			case EditInsert:
+				sb.WriteString("+" + e.New + "\n")
+			}
+		}
+		idx = end
+	}
+	return sb.String()
+}
+
+// hunkRange formats the start and length of one side of a hunk header.
+// Following the unified format, an empty range names the line before it.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
@@ -0,0 +1,113 @@
+package steams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func editKinds[T any](edits It[Edit[T]]) string {
+	return JoinStrings(Map(edits, func(e Edit[T]) string {
+		switch e.Kind {
+		case EditInsert:
+			return "+"
+		case EditDelete:
+			return "-"
+		case EditModify:
+			return "~"
+		default:
+			return "="
+		}
+	}), "")
+}
+
+// applyEdits rebuilds the new sequence from the old one and an edit script.
+func applyEdits[T any](edits It[Edit[T]]) []T {
+	var result []T
+	for e := range edits {
+		if e.Kind != EditDelete {
+			result = append(result, e.New)
+		}
+	}
+	return result
+}
+
+func TestDiffSeq(t *testing.T) {
+	eq := func(a, b string) bool { return a == b }
+	old := From("a", "b", "c", "a", "b", "b", "a")
+	new := From("c", "b", "a", "b", "a", "c")
+
+	edits := DiffSeq(old, new, eq)
+	assert.Equal(t, new.Collect(), applyEdits(edits))
+	assert.Equal(t, 5, len(edits.Filter(func(e Edit[string]) bool { return e.Kind != EditEqual }).Collect()), "Myers finds the shortest edit script")
+
+	assert.Equal(t, "===", editKinds(DiffSeq(From(1, 2, 3), From(1, 2, 3), func(a, b int) bool { return a == b })))
+	assert.Equal(t, "=+=", editKinds(DiffSeq(From(1, 3), From(1, 2, 3), func(a, b int) bool { return a == b })))
+	assert.Equal(t, "=-=", editKinds(DiffSeq(From(1, 2, 3), From(1, 3), func(a, b int) bool { return a == b })))
+	assert.Equal(t, "", editKinds(DiffSeq(From[int](), From[int](), func(a, b int) bool { return a == b })))
+	assert.Equal(t, "++", editKinds(DiffSeq(From[int](), From(1, 2), func(a, b int) bool { return a == b })))
+
+	first := DiffSeq(From(1, 3), From(1, 2, 3), func(a, b int) bool { return a == b }).Collect()
+	assert.Equal(t, Edit[int]{Kind: EditEqual, OldIndex: 0, NewIndex: 0, Old: 1, New: 1}, first[0])
+	assert.Equal(t, Edit[int]{Kind: EditInsert, OldIndex: -1, NewIndex: 1, New: 2}, first[1])
+	assert.Equal(t, Edit[int]{Kind: EditEqual, OldIndex: 1, NewIndex: 2, Old: 3, New: 3}, first[2])
+}
+
+func TestDiffBy(t *testing.T) {
+	type record struct {
+		ID   int
+		Name string
+	}
+	old := From(record{1, "a"}, record{2, "b"}, record{3, "c"})
+	new := From(record{1, "a"}, record{3, "C"}, record{4, "d"})
+
+	edits := DiffBy(old, new, func(r record) int { return r.ID }, func(a, b record) bool { return a == b })
+	assert.Equal(t, "=-~+", editKinds(edits))
+
+	modified := edits.Find(func(e Edit[record]) bool { return e.Kind == EditModify }).AsValue()
+	assert.Equal(t, record{3, "c"}, modified.Old)
+	assert.Equal(t, record{3, "C"}, modified.New)
+}
+
+func TestEditKindString(t *testing.T) {
+	assert.Equal(t, "Insert", EditInsert.String())
+	assert.Equal(t, "EditKind(9)", EditKind(9).String())
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	old := From("one", "two", "three", "four", "five", "six", "seven", "eight", "nine")
+	new := From("one", "2", "three", "four", "five", "six", "seven", "eight", "nine", "ten")
+
+	expected := "--- old.txt\n" +
+		"+++ new.txt\n" +
+		"@@ -1,4 +1,4 @@\n" +
+		" one\n" +
+		"-two\n" +
+		"+2\n" +
+		" three\n" +
+		" four\n" +
+		"@@ -8,2 +8,3 @@\n" +
+		" eight\n" +
+		" nine\n" +
+		"+ten\n"
+	assert.Equal(t, expected, UnifiedDiff(old, new, "old.txt", "new.txt", 2))
+
+	merged := "--- a\n" +
+		"+++ b\n" +
+		"@@ -1,9 +1,10 @@\n" +
+		" one\n" +
+		"-two\n" +
+		"+2\n" +
+		" three\n" +
+		" four\n" +
+		" five\n" +
+		" six\n" +
+		" seven\n" +
+		" eight\n" +
+		" nine\n" +
+		"+ten\n"
+	assert.Equal(t, merged, UnifiedDiff(old, new, "a", "b", 4))
+
+	assert.Equal(t, "--- a\n+++ b\n@@ -0,0 +1 @@\n+x\n", UnifiedDiff(From[string](), From("x"), "a", "b", 3))
+	assert.Equal(t, "", UnifiedDiff(old, old, "a", "b", 3))
+}
@@ -9,10 +9,7 @@ import (
 func main() {
 	s1 := steams.From(1, 2, 3)
 	s2 := steams.From("a", "b", "c")
-	steams.Zip(s1, s2).ForEach(func(s struct {
-		First  int
-		Second string
-	}) {
+	steams.Zip(s1, s2).ForEach(func(s steams.Pair[int, string]) {
 		fmt.Println(s.First, s.Second)
 	})
 }
@@ -34,6 +34,9 @@ func OrderStructAsc[T OrderedStruct[T]](a, b T) bool {
 
 // OrderDesc compares two Ordered values in descending order.
 // It returns -1 if the first value is less than the second.
+//
+// Deprecated: OrderDesc never returns a positive value, so it is not a
+// valid comparison function for sorting. Use Reversed(Natural[T]) instead.
 func OrderDesc[T Ordered](a, b T) int {
 	if a > b {
 		return 0
@@ -43,6 +46,9 @@ func OrderDesc[T Ordered](a, b T) int {
 
 // OrderAsc compares two Ordered values in ascending order.
 // It returns -1 if the first value is greater than the second.
+//
+// Deprecated: OrderAsc never returns a positive value, so it is not a
+// valid comparison function for sorting. Use Natural[T] instead.
 func OrderAsc[T Ordered](a, b T) int {
 	if a < b {
 		return 0
@@ -0,0 +1,280 @@
+package steams
+
+import (
+	"container/heap"
+	"fmt"
+	"strings"
+
+	"github.com/javiorfo/nilo"
+)
+
+// Edge is a directed edge between two nodes of a graph, with an optional
+// weight used by ShortestPath.
+type Edge[N comparable, W Number] struct {
+	From   N
+	To     N
+	Weight W
+}
+
+// Adjacency collects the edges into a directed adjacency function that
+// returns the targets of the edges leaving a node, in the order the edges
+// were given. Note: the edges are consumed eagerly.
+func Adjacency[N comparable, W Number](edges It[Edge[N, W]]) func(N) It[N] {
+	adjacency := make(map[N][]N)
+	for e := range edges {
+		adjacency[e.From] = append(adjacency[e.From], e.To)
+	}
+	return func(n N) It[N] {
+		return FromSlice(adjacency[n])
+	}
+}
+
+// UndirectedAdjacency is an Adjacency variant that treats every edge as
+// going both ways, as expected by ConnectedComponents.
+func UndirectedAdjacency[N comparable, W Number](edges It[Edge[N, W]]) func(N) It[N] {
+	adjacency := make(map[N][]N)
+	for e := range edges {
+		adjacency[e.From] = append(adjacency[e.From], e.To)
+		if e.From != e.To {
+			adjacency[e.To] = append(adjacency[e.To], e.From)
+		}
+	}
+	return func(n N) It[N] {
+		return FromSlice(adjacency[n])
+	}
+}
+
+// WeightedAdjacency collects the edges into a directed adjacency function
+// that returns the targets of the edges leaving a node together with their
+// weights, as expected by ShortestPath. Note: the edges are consumed eagerly.
+func WeightedAdjacency[N comparable, W Number](edges It[Edge[N, W]]) func(N) It2[N, W] {
+	adjacency := make(map[N][]Edge[N, W])
+	for e := range edges {
+		adjacency[e.From] = append(adjacency[e.From], e)
+	}
+	return func(n N) It2[N, W] {
+		return func(yield func(N, W) bool) {
+			for _, e := range adjacency[n] {
+				if !yield(e.To, e.Weight) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// BFS returns an iterator that visits the nodes reachable from start in
+// breadth first order. Every node is yielded once, so it is safe on graphs
+// with cycles. Neighbours are only requested when a node is reached.
+func BFS[N comparable](start N, neighbours func(N) It[N]) It[N] {
+	return LevelOrderBy(start, neighbours, identity[N])
+}
+
+// DFS returns an iterator that visits the nodes reachable from start in
+// depth first order. Every node is yielded once, so it is safe on graphs
+// with cycles. Neighbours are only requested when a node is reached.
+func DFS[N comparable](start N, neighbours func(N) It[N]) It[N] {
+	return PreOrderBy(start, neighbours, identity[N])
+}
+
+// CycleError is returned by TopologicalSort when the graph contains a
+// cycle. Cycle lists the nodes of one such cycle, starting and ending with
+// the same node.
+type CycleError[N comparable] struct {
+	Cycle []N
+}
+
+func (e *CycleError[N]) Error() string {
+	nodes := make([]string, len(e.Cycle))
+	for i, n := range e.Cycle {
+		nodes[i] = fmt.Sprint(n)
+	}
+	return "steams: cycle detected: " + strings.Join(nodes, " -> ")
+}
+
+// TopologicalSort orders the given nodes, and every node reachable from
+// them, so that each node comes before its neighbours. Independent nodes
+// keep the order in which they were discovered. If the graph contains a
+// cycle, it returns a *CycleError describing one of them.
+// Note: the whole graph is explored before the result is returned.
+func TopologicalSort[N comparable](nodes It[N], neighbours func(N) It[N]) (It[N], error) {
+	var order []N
+	successors := make(map[N][]N)
+	predecessors := make(map[N][]N)
+	inDegree := make(map[N]int)
+
+	var discover func(N)
+	discover = func(n N) {
+		if _, exists := successors[n]; exists {
+			return
+		}
+		order = append(order, n)
+		successors[n] = neighbours(n).Collect()
+		for _, next := range successors[n] {
+			inDegree[next]++
+			predecessors[next] = append(predecessors[next], n)
+			discover(next)
+		}
+	}
+	for n := range nodes {
+		discover(n)
+	}
+
+	// Kahn's algorithm, seeding the queue in discovery order.
+	sorted := make([]N, 0, len(order))
+	for _, n := range order {
+		if inDegree[n] == 0 {
+			sorted = append(sorted, n)
+		}
+	}
+	for i := 0; i < len(sorted); i++ {
+		for _, next := range successors[sorted[i]] {
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				sorted = append(sorted, next)
+			}
+		}
+	}
+
+	if len(sorted) < len(order) {
+		for _, n := range order {
+			if inDegree[n] > 0 {
+				return nil, &CycleError[N]{Cycle: findCycle(n, predecessors, inDegree)}
+			}
+		}
+	}
+	return FromSlice(sorted), nil
+}
+
+// findCycle walks backwards from a node left unsorted by Kahn's algorithm.
+// Every such node has an unsorted predecessor, so the walk must eventually
+// revisit a node, closing a cycle.
+func findCycle[N comparable](start N, predecessors map[N][]N, inDegree map[N]int) []N {
+	position := make(map[N]int)
+	var walk []N
+	n := start
+	for {
+		if i, exists := position[n]; exists {
+			cycle := append(walk[i:], n)
+			for l, r := 0, len(cycle)-1; l < r; l, r = l+1, r-1 {
+				cycle[l], cycle[r] = cycle[r], cycle[l]
+			}
+			return cycle
+		}
+		position[n] = len(walk)
+		walk = append(walk, n)
+		for _, prev := range predecessors[n] {
+			if inDegree[prev] > 0 {
+				n = prev
+				break
+			}
+		}
+	}
+}
+
+// ConnectedComponents groups the given nodes, and every node reachable
+// from them, into connected components. Components are yielded lazily in
+// the order their first node appears, each in breadth first order. The
+// neighbours function is expected to be symmetric, as the one built by
+// UndirectedAdjacency; otherwise the result depends on the node order.
+func ConnectedComponents[N comparable](nodes It[N], neighbours func(N) It[N]) It[It[N]] {
+	return func(yield func(It[N]) bool) {
+		seen := make(map[N]struct{})
+		for n := range nodes {
+			if _, exists := seen[n]; exists {
+				continue
+			}
+			var component []N
+			queue := []N{n}
+			seen[n] = struct{}{}
+			for len(queue) > 0 {
+				current := queue[0]
+				queue = queue[1:]
+				component = append(component, current)
+				for next := range neighbours(current) {
+					if _, exists := seen[next]; !exists {
+						seen[next] = struct{}{}
+						queue = append(queue, next)
+					}
+				}
+			}
+			if !yield(FromSlice(component)) {
+				return
+			}
+		}
+	}
+}
+
+// Path is a route through a graph together with its total cost.
+type Path[N comparable, W Number] struct {
+	Nodes []N
+	Cost  W
+}
+
+// ShortestPath finds the cheapest path from start to goal using Dijkstra's
+// algorithm. It returns nilo.Nil if goal is not reachable. Weights must not
+// be negative; otherwise the result is a valid path from start to goal but
+// not guaranteed to be the cheapest, since settled nodes are never revisited.
+func ShortestPath[N comparable, W Number](start, goal N, neighbours func(N) It2[N, W]) nilo.Option[Path[N, W]] {
+	costs := map[N]W{start: 0}
+	previous := make(map[N]N)
+	done := make(map[N]struct{})
+	queue := &pathQueue[N, W]{{node: start}}
+
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(pathItem[N, W])
+		if _, exists := done[current.node]; exists {
+			continue
+		}
+		done[current.node] = struct{}{}
+
+		if current.node == goal {
+			nodes := []N{goal}
+			for n := goal; n != start; {
+				n = previous[n]
+				nodes = append(nodes, n)
+			}
+			for l, r := 0, len(nodes)-1; l < r; l, r = l+1, r-1 {
+				nodes[l], nodes[r] = nodes[r], nodes[l]
+			}
+			return nilo.Value(Path[N, W]{Nodes: nodes, Cost: current.cost})
+		}
+
+		for next, weight := range neighbours(current.node) {
+			if _, exists := done[next]; exists {
+				continue
+			}
+			cost := current.cost + weight
+			if known, exists := costs[next]; !exists || cost < known {
+				costs[next] = cost
+				previous[next] = current.node
+				heap.Push(queue, pathItem[N, W]{node: next, cost: cost})
+			}
+		}
+	}
+	return nilo.Nil[Path[N, W]]()
+}
+
+// pathItem is a node waiting in the ShortestPath queue.
+type pathItem[N comparable, W Number] struct {
+	node N
+	cost W
+}
+
+// pathQueue is a min-heap of pathItem ordered by cost.
+type pathQueue[N comparable, W Number] []pathItem[N, W]
+
+func (q pathQueue[N, W]) Len() int           { return len(q) }
+func (q pathQueue[N, W]) Less(i, j int) bool { return q[i].cost < q[j].cost }
+func (q pathQueue[N, W]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *pathQueue[N, W]) Push(x any) {
+	*q = append(*q, x.(pathItem[N, W]))
+}
+
+func (q *pathQueue[N, W]) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
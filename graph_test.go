@@ -0,0 +1,115 @@
+package steams
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func graphEdges(pairs ...string) It[Edge[string, int]] {
+	edges := make([]Edge[string, int], 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		edges = append(edges, Edge[string, int]{From: pairs[i], To: pairs[i+1], Weight: 1})
+	}
+	return FromSlice(edges)
+}
+
+func TestBFSAndDFS(t *testing.T) {
+	neighbours := Adjacency(graphEdges("a", "b", "a", "c", "b", "d", "c", "d", "d", "a"))
+
+	assert.Equal(t, []string{"a", "b", "c", "d"}, BFS("a", neighbours).Collect())
+	assert.Equal(t, []string{"a", "b", "d", "c"}, DFS("a", neighbours).Collect())
+	assert.Equal(t, []string{"d", "a", "b", "c"}, BFS("d", neighbours).Collect())
+	assert.Equal(t, []string{"x"}, DFS("x", neighbours).Collect())
+
+	requested := 0
+	counting := func(n string) It[string] {
+		requested++
+		return neighbours(n)
+	}
+	assert.Equal(t, []string{"a", "b"}, BFS("a", counting).Take(2).Collect())
+	assert.Equal(t, 1, requested)
+}
+
+func TestTopologicalSort(t *testing.T) {
+	neighbours := Adjacency(graphEdges("shirt", "tie", "tie", "jacket", "trousers", "shoes", "trousers", "belt", "belt", "jacket", "socks", "shoes"))
+
+	sorted, err := TopologicalSort(From("shirt", "trousers", "socks", "watch"), neighbours)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"shirt", "trousers", "socks", "watch", "tie", "belt", "shoes", "jacket"}, sorted.Collect())
+
+	position := make(map[string]int)
+	for i, n := range sorted.Collect() {
+		position[n] = i
+	}
+	for e := range graphEdges("shirt", "tie", "tie", "jacket", "trousers", "shoes", "trousers", "belt", "belt", "jacket", "socks", "shoes") {
+		assert.Less(t, position[e.From], position[e.To])
+	}
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	neighbours := Adjacency(graphEdges("a", "b", "b", "c", "c", "d", "d", "b"))
+
+	sorted, err := TopologicalSort(From("a"), neighbours)
+	assert.Nil(t, sorted)
+
+	var cycleErr *CycleError[string]
+	assert.True(t, errors.As(err, &cycleErr))
+	assert.Equal(t, []string{"b", "c", "d", "b"}, cycleErr.Cycle)
+	assert.Equal(t, "steams: cycle detected: b -> c -> d -> b", err.Error())
+
+	_, err = TopologicalSort(From("x"), Adjacency(graphEdges("x", "x")))
+	assert.EqualError(t, err, "steams: cycle detected: x -> x")
+}
+
+func TestConnectedComponents(t *testing.T) {
+	neighbours := UndirectedAdjacency(graphEdges("a", "b", "c", "b", "d", "e"))
+
+	components := Map(ConnectedComponents(From("a", "b", "c", "d", "e", "f"), neighbours), func(c It[string]) []string {
+		return c.Collect()
+	}).Collect()
+	assert.Equal(t, [][]string{{"a", "b", "c"}, {"d", "e"}, {"f"}}, components)
+
+	first := ConnectedComponents(From("d", "a"), neighbours).Take(1).Collect()
+	assert.Equal(t, []string{"d", "e"}, first[0].Collect())
+}
+
+func TestShortestPath(t *testing.T) {
+	edges := From(
+		Edge[string, float64]{From: "a", To: "b", Weight: 7},
+		Edge[string, float64]{From: "a", To: "c", Weight: 9},
+		Edge[string, float64]{From: "a", To: "f", Weight: 14},
+		Edge[string, float64]{From: "b", To: "c", Weight: 10},
+		Edge[string, float64]{From: "b", To: "d", Weight: 15},
+		Edge[string, float64]{From: "c", To: "d", Weight: 11},
+		Edge[string, float64]{From: "c", To: "f", Weight: 2},
+		Edge[string, float64]{From: "d", To: "e", Weight: 6},
+		Edge[string, float64]{From: "f", To: "e", Weight: 9.5},
+	)
+	neighbours := WeightedAdjacency(edges)
+
+	path := ShortestPath("a", "e", neighbours)
+	assert.True(t, path.IsValue())
+	assert.Equal(t, []string{"a", "c", "f", "e"}, path.AsValue().Nodes)
+	assert.Equal(t, 20.5, path.AsValue().Cost)
+
+	same := ShortestPath("a", "a", neighbours)
+	assert.Equal(t, Path[string, float64]{Nodes: []string{"a"}}, same.AsValue())
+
+	assert.True(t, ShortestPath("e", "a", neighbours).IsNil())
+}
+
+func TestShortestPathNegativeWeight(t *testing.T) {
+	edges := From(
+		Edge[string, int]{From: "S", To: "A", Weight: 1},
+		Edge[string, int]{From: "A", To: "B", Weight: 1},
+		Edge[string, int]{From: "B", To: "A", Weight: -5},
+		Edge[string, int]{From: "B", To: "G", Weight: 1},
+	)
+
+	path := ShortestPath("S", "G", WeightedAdjacency(edges))
+	assert.True(t, path.IsValue())
+	assert.Equal(t, []string{"S", "A", "B", "G"}, path.AsValue().Nodes)
+	assert.Equal(t, 3, path.AsValue().Cost)
+}
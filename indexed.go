@@ -0,0 +1,125 @@
+package steams
+
+import "github.com/javiorfo/nilo"
+
+// Indexed is a finite sequence with a known length and random access to
+// its elements. Count, Nth, Last, Reverse and RPosition answer without
+// traversing or copying it, and Map, Take and Skip return another Indexed.
+// Call AsIt to continue with the full It API.
+//
+// Unlike It, elements are produced on demand by index: a mapper added with
+// Map runs every time an element is accessed, in whatever order the
+// elements are requested, and not at all for elements that are skipped or
+// only counted. Stateful mappers should be applied after AsIt instead.
+type Indexed[T any] struct {
+	n  int
+	at func(int) T
+}
+
+// FromIndexed creates an Indexed of n elements whose element i is at(i).
+// A negative n is treated as 0.
+func FromIndexed[T any](n int, at func(int) T) Indexed[T] {
+	return Indexed[T]{n: max(n, 0), at: at}
+}
+
+// FromSliceIndexed creates an Indexed over the elements of the slice.
+// The slice is not copied, so later changes to it are visible.
+func FromSliceIndexed[T any](slice []T) Indexed[T] {
+	return FromIndexed(len(slice), func(i int) T {
+		return slice[i]
+	})
+}
+
+// AsIt returns an It that yields the elements in order.
+func (ix Indexed[T]) AsIt() It[T] {
+	return func(yield func(T) bool) {
+		for i := range ix.n {
+			if !yield(ix.at(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of elements.
+func (ix Indexed[T]) Len() int {
+	return ix.n
+}
+
+// Count returns the number of elements without accessing any of them.
+func (ix Indexed[T]) Count() int {
+	return ix.n
+}
+
+// Nth returns the element at the given index, if it exists.
+func (ix Indexed[T]) Nth(n int) nilo.Option[T] {
+	if n < 0 || n >= ix.n {
+		return nilo.Nil[T]()
+	}
+	return nilo.Value(ix.at(n))
+}
+
+// First returns the first element, if any.
+func (ix Indexed[T]) First() nilo.Option[T] {
+	return ix.Nth(0)
+}
+
+// Last returns the final element, if any.
+func (ix Indexed[T]) Last() nilo.Option[T] {
+	return ix.Nth(ix.n - 1)
+}
+
+// Reverse returns an Indexed over the elements in reverse order.
+func (ix Indexed[T]) Reverse() Indexed[T] {
+	return FromIndexed(ix.n, func(i int) T {
+		return ix.at(ix.n - 1 - i)
+	})
+}
+
+// RPosition returns the index of the last element satisfying the
+// predicate, checking the elements from the end.
+func (ix Indexed[T]) RPosition(predicate func(T) bool) nilo.Option[int] {
+	for index := ix.n - 1; index >= 0; index-- {
+		if predicate(ix.at(index)) {
+			return nilo.Value(index)
+		}
+	}
+	return nilo.Nil[int]()
+}
+
+// Map returns an Indexed that applies the mapper to each element when it
+// is accessed. See the Indexed documentation for when the mapper runs.
+func (ix Indexed[T]) Map(mapper func(T) T) Indexed[T] {
+	return MapIndexed(ix, mapper)
+}
+
+// Take returns an Indexed over at most the first n elements.
+func (ix Indexed[T]) Take(n int) Indexed[T] {
+	return FromIndexed(min(n, ix.n), ix.at)
+}
+
+// Skip returns an Indexed without the first n elements.
+// Skipped elements are never accessed.
+func (ix Indexed[T]) Skip(n int) Indexed[T] {
+	skip := max(min(n, ix.n), 0)
+	return FromIndexed(ix.n-skip, func(i int) T {
+		return ix.at(i + skip)
+	})
+}
+
+// Collect returns the elements in a new slice.
+func (ix Indexed[T]) Collect() []T {
+	result := make([]T, ix.n)
+	for i := range result {
+		result[i] = ix.at(i)
+	}
+	return result
+}
+
+// MapIndexed is the type-changing variant of Indexed.Map. The transform
+// runs each time an element is accessed.
+func MapIndexed[T, U any](ix Indexed[T], transform func(T) U) Indexed[U] {
+	return FromIndexed(ix.n, func(i int) U {
+		return transform(ix.at(i))
+	})
+}
@@ -0,0 +1,127 @@
+package steams
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/javiorfo/nilo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexed(t *testing.T) {
+	ix := FromSliceIndexed([]int{1, 2, 3, 4, 5})
+
+	assert.Equal(t, 5, ix.Len())
+	assert.Equal(t, 5, ix.Count())
+	assert.Equal(t, nilo.Value(1), ix.First())
+	assert.Equal(t, nilo.Value(5), ix.Last())
+	assert.Equal(t, nilo.Value(3), ix.Nth(2))
+	assert.True(t, ix.Nth(5).IsNil())
+	assert.True(t, ix.Nth(-1).IsNil())
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, ix.Reverse().Collect())
+	assert.Equal(t, nilo.Value(3), ix.RPosition(func(i int) bool { return i%2 == 0 }))
+	assert.True(t, ix.RPosition(func(i int) bool { return i > 5 }).IsNil())
+	assert.Equal(t, []int{2, 3}, ix.Skip(1).Take(2).Collect())
+	assert.Equal(t, 0, ix.Skip(10).Count())
+	assert.Equal(t, 0, ix.Take(-1).Count())
+	assert.Equal(t, []int{4, 5}, ix.AsIt().Filter(func(i int) bool { return i > 3 }).Collect())
+
+	empty := FromSliceIndexed([]string{})
+	assert.True(t, empty.First().IsNil())
+	assert.True(t, empty.Last().IsNil())
+	assert.Equal(t, 0, FromIndexed(-3, strconv.Itoa).Len())
+}
+
+func TestIndexedMap(t *testing.T) {
+	calls := 0
+	mapped := FromSliceIndexed([]int{1, 2, 3, 4, 5}).Map(func(i int) int {
+		calls++
+		return i * 10
+	})
+
+	assert.Equal(t, 5, mapped.Count())
+	assert.Equal(t, 0, calls, "Count should not call the mapper")
+	assert.Equal(t, nilo.Value(50), mapped.Last())
+	assert.Equal(t, nilo.Value(30), mapped.Nth(2))
+	assert.Equal(t, 2, calls, "Last and Nth should call the mapper once each")
+	assert.Equal(t, []int{40, 50}, mapped.Skip(3).Collect())
+	assert.Equal(t, 4, calls, "Skip should not call the mapper for skipped elements")
+
+	strs := MapIndexed(FromIndexed(3, func(i int) int { return i }), strconv.Itoa)
+	assert.Equal(t, []string{"2", "1", "0"}, strs.Reverse().Collect())
+}
+
+func TestIndexedStatefulMapper(t *testing.T) {
+	total := 0
+	runningTotal := func(x int) int {
+		total += x
+		return total
+	}
+
+	// Indexed evaluates the mapper on access, in the order elements are requested.
+	assert.Equal(t, []int{3, 5, 6}, FromSliceIndexed([]int{1, 2, 3}).Map(runningTotal).Reverse().Collect())
+
+	// Applying it after AsIt keeps the in-order evaluation of It.
+	total = 0
+	assert.Equal(t, []int{6, 3, 1}, FromSliceIndexed([]int{1, 2, 3}).AsIt().Map(runningTotal).Reverse().Collect())
+}
+
+func benchmarkSlice() []int {
+	return rangeSlice(100_000)
+}
+
+func BenchmarkCountIt(b *testing.B) {
+	it := FromSlice(benchmarkSlice()).Map(func(i int) int { return i * 2 })
+	for range b.N {
+		_ = it.Count()
+	}
+}
+
+func BenchmarkCountIndexed(b *testing.B) {
+	ix := FromSliceIndexed(benchmarkSlice()).Map(func(i int) int { return i * 2 })
+	for range b.N {
+		_ = ix.Count()
+	}
+}
+
+func BenchmarkLastIt(b *testing.B) {
+	it := FromSlice(benchmarkSlice())
+	for range b.N {
+		_ = it.Last()
+	}
+}
+
+func BenchmarkLastIndexed(b *testing.B) {
+	ix := FromSliceIndexed(benchmarkSlice())
+	for range b.N {
+		_ = ix.Last()
+	}
+}
+
+func BenchmarkNthIt(b *testing.B) {
+	it := FromSlice(benchmarkSlice())
+	for range b.N {
+		_ = it.Nth(90_000)
+	}
+}
+
+func BenchmarkNthIndexed(b *testing.B) {
+	ix := FromSliceIndexed(benchmarkSlice())
+	for range b.N {
+		_ = ix.Nth(90_000)
+	}
+}
+
+func BenchmarkReverseIt(b *testing.B) {
+	it := FromSlice(benchmarkSlice())
+	for range b.N {
+		_ = it.Reverse().First()
+	}
+}
+
+func BenchmarkReverseIndexed(b *testing.B) {
+	ix := FromSliceIndexed(benchmarkSlice())
+	for range b.N {
+		_ = ix.Reverse().First()
+	}
+}
@@ -1,9 +1,6 @@
 package steams
 
-import (
-	"iter"
-	"slices"
-)
+import "iter"
 
 // Distinct returns an iterator that yields only unique elements from the
 // input iterator. It uses a map to track seen elements, which requires
@@ -59,6 +56,42 @@ func Fold[T any, R any](i It[T], initial R, accumulator func(R, T) R) R {
 	return result
 }
 
+// Scan is like Fold, but yields every intermediate accumulator value
+// instead of only the final one. The initial value itself is not yielded.
+// Useful for prefix sums, running balances or cumulative maximums.
+func Scan[T any, R any](i It[T], initial R, accumulator func(R, T) R) It[R] {
+	return func(yield func(R) bool) {
+		result := initial
+		for v := range i {
+			result = accumulator(result, v)
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// ScanByKey is a Scan variant for key-value sequences that keeps a
+// separate accumulator for each key, starting from initial. For every
+// pair it yields the key and that key's updated accumulator.
+// It requires O(K) memory, where K is the number of distinct keys.
+func ScanByKey[K comparable, V any, R any](i It2[K, V], initial R, accumulator func(R, V) R) It2[K, R] {
+	return func(yield func(K, R) bool) {
+		state := make(map[K]R)
+		for k, v := range i {
+			acc, exists := state[k]
+			if !exists {
+				acc = initial
+			}
+			acc = accumulator(acc, v)
+			state[k] = acc
+			if !yield(k, acc) {
+				return
+			}
+		}
+	}
+}
+
 // RFold reduces the iterator to a single value by processing elements
 // from right to left. Note: This triggers a full collection of the
 // iterator into memory.
@@ -99,9 +132,7 @@ func GroupBy[K comparable, V any](i It[V], classifier func(V) K) It2[K, It[V]] {
 		}
 
 		for k, slice := range groups {
-			valIter := It[V](slices.Values(slice))
-
-			if !yield(k, valIter) {
+			if !yield(k, FromSlice(slice)) {
 				return
 			}
 		}
@@ -127,38 +158,13 @@ func GroupByCounting[K comparable, V any](i It[V], classifier func(V) K) It2[K,
 	}
 }
 
-// Zip combines two iterators into a single iterator of structs
+// Zip combines two iterators into a single iterator of pairs
 // containing elements from both. It stops as soon as either input
 // iterator is exhausted.
-func Zip[T, R any](i1 It[T], i2 It[R]) It[struct {
-	First  T
-	Second R
-}] {
-	return func(yield func(struct {
-		First  T
-		Second R
-	}) bool) {
-		next1, stop1 := iter.Pull(iter.Seq[T](i1))
-		defer stop1()
-		next2, stop2 := iter.Pull(iter.Seq[R](i2))
-		defer stop2()
-
-		for {
-			val1, ok1 := next1()
-			val2, ok2 := next2()
-
-			if !ok1 || !ok2 {
-				return
-			}
-
-			if !yield(struct {
-				First  T
-				Second R
-			}{First: val1, Second: val2}) {
-				return
-			}
-		}
-	}
+func Zip[T, R any](i1 It[T], i2 It[R]) It[Pair[T, R]] {
+	return ZipWith(i1, i2, func(v1 T, v2 R) Pair[T, R] {
+		return Pair[T, R]{First: v1, Second: v2}
+	})
 }
 
 // CollectItToIt2 transforms a single-value iterator into a two-value
@@ -186,6 +192,56 @@ func CollectIt2ToIt[K comparable, V, R any](i It2[K, V], mapper func(K, V) R) It
 	}
 }
 
+// MapKeys returns a key-value iterator that applies the mapper to every
+// key, keeping the values. The key type may change.
+func MapKeys[K comparable, K2 comparable, V any](i It2[K, V], mapper func(K) K2) It2[K2, V] {
+	return MapEntries(i, func(k K, v V) (K2, V) {
+		return mapper(k), v
+	})
+}
+
+// MapValues returns a key-value iterator that applies the mapper to every
+// value, keeping the keys. The value type may change.
+func MapValues[K comparable, V any, V2 any](i It2[K, V], mapper func(V) V2) It2[K, V2] {
+	return MapEntries(i, func(k K, v V) (K, V2) {
+		return k, mapper(v)
+	})
+}
+
+// MapEntries returns a key-value iterator that applies the mapper to every
+// pair. Both the key and the value types may change.
+func MapEntries[K comparable, V any, K2 comparable, V2 any](i It2[K, V], mapper func(K, V) (K2, V2)) It2[K2, V2] {
+	return func(yield func(K2, V2) bool) {
+		for k, v := range i {
+			if !yield(mapper(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// Swap returns a key-value iterator in which every key becomes the value
+// and every value becomes the key.
+func Swap[K comparable, V comparable](i It2[K, V]) It2[V, K] {
+	return MapEntries(i, func(k K, v V) (V, K) {
+		return v, k
+	})
+}
+
+// FlatMapValues applies a mapper that returns an iterator for each value,
+// yielding the original key with every element of that iterator.
+func FlatMapValues[K comparable, V any, V2 any](i It2[K, V], mapper func(V) It[V2]) It2[K, V2] {
+	return func(yield func(K, V2) bool) {
+		for k, v := range i {
+			for inner := range mapper(v) {
+				if !yield(k, inner) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // ChainAll concatenates multiple iterators into a single iterator
 // that yields all elements from the first, then the second, and so on.
 func ChainAll[V any](its ...It[V]) It[V] {
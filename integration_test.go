@@ -1,6 +1,7 @@
 package steams
 
 import (
+	"fmt"
 	"slices"
 	"testing"
 
@@ -110,6 +111,47 @@ func TestIntegrationFold(t *testing.T) {
 	}
 }
 
+func TestIntegrationScan(t *testing.T) {
+	sums := Scan(From(1, 2, 3, 4), 0, func(acc, v int) int { return acc + v })
+	assert.Equal(t, []int{1, 3, 6, 10}, sums.Collect())
+
+	maxes := Scan(From(3, 1, 4, 1, 5), 0, func(acc, v int) int { return max(acc, v) })
+	assert.Equal(t, []int{3, 3, 4, 4, 5}, maxes.Collect())
+
+	concat := Scan(From("a", "bb", "ccc"), "", func(acc string, v string) string { return acc + v })
+	assert.Equal(t, []string{"a", "abb"}, concat.Take(2).Collect())
+
+	var empty []int
+	assert.Equal(t, empty, Scan(From[int](), 0, func(acc, v int) int { return acc + v }).Collect())
+}
+
+func TestIntegrationScanByKey(t *testing.T) {
+	type movement struct {
+		Account string
+		Amount  int
+	}
+	movements := From(
+		movement{"a", 10},
+		movement{"b", 5},
+		movement{"a", -3},
+		movement{"b", 7},
+		movement{"a", 1},
+	)
+	byAccount := CollectItToIt2(movements,
+		func(m movement) string { return m.Account },
+		func(m movement) int { return m.Amount })
+
+	var accounts []string
+	var balances []int
+	ScanByKey(byAccount, 0, Sum[int]).ForEach(func(k string, v int) {
+		accounts = append(accounts, k)
+		balances = append(balances, v)
+	})
+
+	assert.Equal(t, []string{"a", "b", "a", "b", "a"}, accounts)
+	assert.Equal(t, []int{10, 5, 7, 12, 8}, balances)
+}
+
 func TestIntegrationRFold(t *testing.T) {
 	chars := From("a", "b", "c")
 	result := RFold(chars, "!", func(v, acc string) string {
@@ -187,10 +229,7 @@ func TestIntegrationGroupBy(t *testing.T) {
 func TestIntegrationZip(t *testing.T) {
 	s1 := From(1, 2, 3)
 	s2 := From("a", "b", "c")
-	expected := []struct {
-		First  int
-		Second string
-	}{
+	expected := []Pair[int, string]{
 		{1, "a"},
 		{2, "b"},
 		{3, "c"},
@@ -224,6 +263,47 @@ func TestIntegrationCollectIt2ToIt(t *testing.T) {
 	assert.Equal(t, []int{2, 4, 6}, result2.SortBy(OrderDesc).Collect())
 }
 
+func TestIntegrationMapKeys(t *testing.T) {
+	it := FromMap(map[int]string{1: "one", 2: "two"})
+	result := MapKeys(it, func(k int) string { return fmt.Sprintf("k%d", k) })
+	assert.Equal(t, map[string]string{"k1": "one", "k2": "two"}, result.Collect())
+}
+
+func TestIntegrationMapValues(t *testing.T) {
+	it := FromMap(map[string]string{"a": "one", "b": "three"})
+	result := MapValues(it, func(v string) int { return len(v) })
+	assert.Equal(t, map[string]int{"a": 3, "b": 5}, result.Collect())
+}
+
+func TestIntegrationMapEntries(t *testing.T) {
+	type user struct {
+		ID    int
+		Email string
+	}
+	byID := FromMap(map[int]user{1: {1, "a@x.com"}, 2: {2, "b@x.com"}})
+	byEmail := MapEntries(byID, func(id int, u user) (string, int) { return u.Email, id })
+	assert.Equal(t, map[string]int{"a@x.com": 1, "b@x.com": 2}, byEmail.Collect())
+}
+
+func TestIntegrationSwap(t *testing.T) {
+	it := FromMap(map[string]int{"a": 1, "b": 2})
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, Swap(it).Collect())
+}
+
+func TestIntegrationFlatMapValues(t *testing.T) {
+	it := ZipToIt2(From("x", "y"), From(2, 1))
+	result := FlatMapValues(it, func(n int) It[int] { return RepeatN(n, n) })
+
+	var keys []string
+	var values []int
+	result.ForEach(func(k string, v int) {
+		keys = append(keys, k)
+		values = append(values, v)
+	})
+	assert.Equal(t, []string{"x", "x", "y"}, keys)
+	assert.Equal(t, []int{2, 2, 1}, values)
+}
+
 func TestIntegrationChainAll(t *testing.T) {
 	tests := []struct {
 		name     string
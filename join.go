@@ -0,0 +1,61 @@
+package steams
+
+import (
+	"io"
+	"iter"
+	"strings"
+)
+
+// JoinStrings concatenates the elements of the iterator, placing sep
+// between adjacent elements. It writes directly into a strings.Builder
+// without collecting an intermediate slice.
+func JoinStrings(i It[string], sep string) string {
+	var sb strings.Builder
+	first := true
+	for v := range i {
+		if !first {
+			sb.WriteString(sep)
+		}
+		first = false
+		sb.WriteString(v)
+	}
+	return sb.String()
+}
+
+// JoinTo writes every element of the iterator to w, formatted with the
+// format function and separated by sep. It stops and returns the first
+// write error.
+func JoinTo[T any](i It[T], w io.Writer, sep string, format func(T) string) error {
+	first := true
+	for v := range i {
+		if !first {
+			if _, err := io.WriteString(w, sep); err != nil {
+				return err
+			}
+		}
+		first = false
+		if _, err := io.WriteString(w, format(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FlattenWithSeparator is a Flatten variant that yields sep between the
+// elements of adjacent nested sequences.
+func FlattenWithSeparator[V any](nested It[iter.Seq[V]], sep V) It[V] {
+	return func(yield func(V) bool) {
+		first := true
+		for innerSeq := range nested {
+			if !first && !yield(sep) {
+				return
+			}
+			first = false
+			for val := range innerSeq {
+				if !yield(val) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package steams
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type failingWriter struct {
+	limit int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.limit == 0 {
+		return 0, errors.New("write failed")
+	}
+	w.limit--
+	return len(p), nil
+}
+
+func TestJoinStrings(t *testing.T) {
+	assert.Equal(t, "a, b, c", JoinStrings(From("a", "b", "c"), ", "))
+	assert.Equal(t, "a", JoinStrings(From("a"), ", "))
+	assert.Equal(t, "", JoinStrings(From[string](), ", "))
+}
+
+func TestJoinTo(t *testing.T) {
+	var sb strings.Builder
+	err := JoinTo(From(1, 2, 3), &sb, " | ", func(i int) string { return fmt.Sprintf("#%d", i) })
+	assert.NoError(t, err)
+	assert.Equal(t, "#1 | #2 | #3", sb.String())
+
+	err = JoinTo(From(1, 2, 3), &failingWriter{limit: 2}, ",", func(i int) string { return fmt.Sprint(i) })
+	assert.EqualError(t, err, "write failed")
+}
+
+func TestFlattenWithSeparator(t *testing.T) {
+	nested := From[iter.Seq[int]](From(1, 2).AsSeq(), From[int]().AsSeq(), From(3).AsSeq())
+	assert.Equal(t, []int{1, 2, 0, 0, 3}, FlattenWithSeparator(nested, 0).Collect())
+	assert.Equal(t, []int{1, 2, 0}, FlattenWithSeparator(nested, 0).Take(3).Collect())
+}
@@ -17,6 +17,7 @@ func From[T any](args ...T) It[T] {
 }
 
 // FromSlice creates a It (iterator) from a slice.
+// Use FromSliceIndexed for O(1) Count, Nth, Last and Reverse.
 func FromSlice[T any](slice []T) It[T] {
 	return It[T](slices.Values(slice))
 }
@@ -173,9 +174,13 @@ func (it It[T]) Take(n int) It[T] {
 }
 
 // Count consumes the iterator and returns the total number of elements.
-// Note: This collects the iterator into memory to determine length.
+// It iterates without collecting the elements into memory.
 func (it It[T]) Count() int {
-	return len(it.Collect())
+	count := 0
+	for range it {
+		count++
+	}
+	return count
 }
 
 // ForEach executes the consumer function for every element in the iterator.
@@ -336,8 +341,7 @@ func (it It[T]) Position(predicate func(T) bool) nilo.Option[int] {
 // Note: This collects the entire sequence into memory first.
 func (it It[T]) RPosition(predicate func(T) bool) nilo.Option[int] {
 	list := it.Collect()
-	length := len(list)
-	for index := length - 1; index >= 0; index-- {
+	for index := len(list) - 1; index >= 0; index-- {
 		if predicate(list[index]) {
 			return nilo.Value(index)
 		}
@@ -359,12 +363,16 @@ func (it It[T]) Enumerate() It2[int, T] {
 }
 
 // Last returns the final element of the iterator.
-// Note: This collects the entire sequence into memory first.
+// It iterates without collecting the elements into memory.
 func (it It[T]) Last() nilo.Option[T] {
-	slice := it.Collect()
-	length := len(slice)
-	if length > 0 {
-		return nilo.Value(slice[length-1])
+	found := false
+	var last T
+	for v := range it {
+		last = v
+		found = true
+	}
+	if found {
+		return nilo.Value(last)
 	}
 	return nilo.Nil[T]()
 }
@@ -401,6 +409,22 @@ func (it It[T]) SortBy(cmp func(T, T) int) It[T] {
 	}
 }
 
+// SortStableBy is a SortBy variant that keeps the original order of
+// elements the comparison function considers equal.
+// Note: This collects and sorts the entire sequence in memory.
+func (it It[T]) SortStableBy(cmp func(T, T) int) It[T] {
+	return func(yield func(T) bool) {
+		buf := it.Collect()
+		slices.SortStableFunc(buf, cmp)
+
+		for _, v := range buf {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
 // Compare finds the "best" element based on the provided comparison function
 // (e.g., to find Min or Max).
 // Note: Use helper functions like steams.Min, steams.Max
@@ -471,3 +495,48 @@ func (it It[T]) Partition(politer func(T) bool) (It[T], It[T]) {
 	}
 	return FromSlice(pos), FromSlice(neg)
 }
+
+// Intersperse returns an iterator that yields sep between every pair of
+// adjacent elements.
+func (it It[T]) Intersperse(sep T) It[T] {
+	return it.IntersperseWith(func() T { return sep })
+}
+
+// IntersperseWith returns an iterator that yields the result of calling
+// separator between every pair of adjacent elements.
+func (it It[T]) IntersperseWith(separator func() T) It[T] {
+	return func(yield func(T) bool) {
+		first := true
+		for v := range it {
+			if !first && !yield(separator()) {
+				return
+			}
+			first = false
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// AdjacentFind returns the index of the first element that, together with
+// the element following it, satisfies the predicate.
+func (it It[T]) AdjacentFind(predicate func(T, T) bool) nilo.Option[int] {
+	index := 0
+	var prev T
+	for v := range it {
+		if index > 0 && predicate(prev, v) {
+			return nilo.Value(index - 1)
+		}
+		prev = v
+		index++
+	}
+	return nilo.Nil[int]()
+}
+
+// IsSortedBy returns true if the elements are in non-decreasing order
+// according to the comparison function. It short-circuits on the first
+// out of order pair.
+func (it It[T]) IsSortedBy(cmp func(T, T) int) bool {
+	return it.AdjacentFind(func(a, b T) bool { return cmp(a, b) > 0 }).IsNil()
+}
@@ -354,3 +354,66 @@ func TestPartition(t *testing.T) {
 		assert.NotContains(t, neg.Collect(), "a")
 	})
 }
+
+func TestIntersperse(t *testing.T) {
+	assert.Equal(t, []string{"a", ",", "b", ",", "c"}, From("a", "b", "c").Intersperse(",").Collect())
+	assert.Equal(t, []int{1}, From(1).Intersperse(0).Collect())
+
+	var empty []int
+	assert.Equal(t, empty, From[int]().Intersperse(0).Collect())
+}
+
+func TestIntersperseWith(t *testing.T) {
+	counter := 0
+	result := From(10, 20, 30).IntersperseWith(func() int {
+		counter++
+		return counter
+	})
+	assert.Equal(t, []int{10, 1, 20, 2, 30}, result.Collect())
+}
+
+func TestAdjacentFind(t *testing.T) {
+	equal := func(a, b int) bool { return a == b }
+	assert.Equal(t, nilo.Value(2), From(1, 2, 3, 3, 4, 4).AdjacentFind(equal))
+	assert.True(t, From(1, 2, 3).AdjacentFind(equal).IsNil())
+	assert.True(t, From[int]().AdjacentFind(equal).IsNil())
+}
+
+func TestIsSortedBy(t *testing.T) {
+	byLen := func(a, b string) int { return len(a) - len(b) }
+	assert.True(t, From("a", "bb", "cc", "ddd").IsSortedBy(byLen))
+	assert.False(t, From("a", "ccc", "bb").IsSortedBy(byLen))
+	assert.True(t, From[string]().IsSortedBy(byLen))
+}
+
+func TestSortStableBy(t *testing.T) {
+	words := From("bb", "a", "cc", "d", "aa")
+	byLen := func(a, b string) int { return len(a) - len(b) }
+	assert.Equal(t, []string{"a", "d", "bb", "cc", "aa"}, words.SortStableBy(byLen).Collect())
+}
+
+func TestStatefulMapperRunsInOrder(t *testing.T) {
+	runningTotal := func() It[int] {
+		total := 0
+		return From(1, 2, 3).Map(func(x int) int {
+			total += x
+			return total
+		})
+	}
+
+	assert.Equal(t, []int{6, 3, 1}, runningTotal().Reverse().Collect())
+	assert.Equal(t, nilo.Value(6), runningTotal().Last())
+	assert.Equal(t, nilo.Value(6), runningTotal().Nth(2))
+	assert.Equal(t, []int{3, 6}, runningTotal().Skip(1).Collect())
+	assert.Equal(t, nilo.Value(2), runningTotal().RPosition(func(x int) bool { return x > 3 }))
+
+	calls := 0
+	counted := Map(From(1, 2, 3), func(x int) string {
+		calls++
+		return fmt.Sprint(x)
+	})
+	assert.Equal(t, 3, counted.Count())
+	assert.Equal(t, 3, calls, "Count should call the mapper for every element")
+	assert.Equal(t, []string{"3"}, counted.Skip(2).Collect())
+	assert.Equal(t, 6, calls, "Skip should call the mapper for skipped elements")
+}
@@ -3,7 +3,7 @@ package steams
 import (
 	"iter"
 	"maps"
-	"sort"
+	"slices"
 
 	"github.com/javiorfo/nilo"
 )
@@ -18,6 +18,11 @@ type Entry[K comparable, V any] struct {
 	Value V
 }
 
+// newEntry pairs a key with its value.
+func newEntry[K comparable, V any](k K, v V) Entry[K, V] {
+	return Entry[K, V]{Key: k, Value: v}
+}
+
 // FromMap creates an It2 iterator from a standard Go map.
 func FromMap[K comparable, V any](m map[K]V) It2[K, V] {
 	return It2[K, V](maps.All(m))
@@ -85,19 +90,54 @@ func (it It2[K, V]) ForEach(consumer func(K, V)) {
 }
 
 // SortBy returns an iterator that yields pairs sorted based on the keys
-// according to the provided comparison function.
+// according to the provided comparison function. Pairs that repeat a key
+// are kept.
 // Note: This collects the entire sequence into memory before sorting.
 func (it It2[K, V]) SortBy(cmp func(K, K) bool) It2[K, V] {
-	return func(yield func(K, V) bool) {
-		var entries []Entry[K, V]
+	return it.sortEntries(LessToCmp(func(a, b Entry[K, V]) bool { return cmp(a.Key, b.Key) }), false)
+}
 
-		for k, v := range it.Collect() {
-			entries = append(entries, Entry[K, V]{k, v})
-		}
+// SortStableBy is a SortBy variant that keeps the original order of
+// pairs whose keys are equivalent.
+func (it It2[K, V]) SortStableBy(cmp func(K, K) bool) It2[K, V] {
+	return it.sortEntries(LessToCmp(func(a, b Entry[K, V]) bool { return cmp(a.Key, b.Key) }), true)
+}
 
-		sort.Slice(entries, func(i, j int) bool {
-			return cmp(entries[i].Key, entries[j].Key)
-		})
+// SortByValue returns an iterator that yields pairs sorted based on the
+// values according to the provided comparison function.
+// Note: This collects the entire sequence into memory before sorting.
+func (it It2[K, V]) SortByValue(cmp func(V, V) int) It2[K, V] {
+	return it.SortByEntry(func(a, b Entry[K, V]) int { return cmp(a.Value, b.Value) })
+}
+
+// SortStableByValue is a SortByValue variant that keeps the original
+// order of pairs whose values are equivalent, such as equal scores.
+func (it It2[K, V]) SortStableByValue(cmp func(V, V) int) It2[K, V] {
+	return it.SortStableByEntry(func(a, b Entry[K, V]) int { return cmp(a.Value, b.Value) })
+}
+
+// SortByEntry returns an iterator that yields pairs sorted according to
+// a comparison function that receives both the key and the value.
+// Note: This collects the entire sequence into memory before sorting.
+func (it It2[K, V]) SortByEntry(cmp func(a, b Entry[K, V]) int) It2[K, V] {
+	return it.sortEntries(cmp, false)
+}
+
+// SortStableByEntry is a SortByEntry variant that keeps the original
+// order of equivalent pairs.
+func (it It2[K, V]) SortStableByEntry(cmp func(a, b Entry[K, V]) int) It2[K, V] {
+	return it.sortEntries(cmp, true)
+}
+
+// sortEntries collects the pairs and yields them sorted by cmp.
+func (it It2[K, V]) sortEntries(cmp func(a, b Entry[K, V]) int, stable bool) It2[K, V] {
+	return func(yield func(K, V) bool) {
+		entries := it.CollectEntries()
+		if stable {
+			slices.SortStableFunc(entries, cmp)
+		} else {
+			slices.SortFunc(entries, cmp)
+		}
 
 		for _, p := range entries {
 			if !yield(p.Key, p.Value) {
@@ -218,8 +258,285 @@ func (it It2[K, V]) Collect() map[K]V {
 	return maps.Collect(iter.Seq2[K, V](it))
 }
 
-// Count consumes the iterator and returns the total number of pairs.
-// Note: This implementation collects the iterator into a map to determine length.
+// Count consumes the iterator and returns the total number of pairs,
+// including pairs that repeat a key.
 func (it It2[K, V]) Count() int {
-	return len(it.Collect())
+	count := 0
+	for range it {
+		count++
+	}
+	return count
+}
+
+// CollectEntries consumes the iterator and returns a slice of all pairs
+// in iteration order, keeping pairs that repeat a key.
+func (it It2[K, V]) CollectEntries() []Entry[K, V] {
+	var result []Entry[K, V]
+	for k, v := range it {
+		result = append(result, newEntry(k, v))
+	}
+	return result
+}
+
+// CollectMulti consumes the iterator and returns a map from every key to
+// all of its values, in iteration order.
+func (it It2[K, V]) CollectMulti() map[K][]V {
+	result := make(map[K][]V)
+	for k, v := range it {
+		result[k] = append(result[k], v)
+	}
+	return result
+}
+
+// CollectWithMerge consumes the iterator and returns a map of all pairs.
+// When a key repeats, merge receives the key, the value collected so far
+// and the new value, and returns the value to keep.
+func (it It2[K, V]) CollectWithMerge(merge func(K, V, V) V) map[K]V {
+	result := make(map[K]V)
+	for k, v := range it {
+		if existing, exists := result[k]; exists {
+			v = merge(k, existing, v)
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// CollectOrdered consumes the iterator and returns an OrderedMap that
+// remembers the order in which keys were first seen, for example after
+// SortBy. When a key repeats, the last value wins but the key keeps its
+// original position.
+func (it It2[K, V]) CollectOrdered() *OrderedMap[K, V] {
+	result := NewOrderedMap[K, V]()
+	for k, v := range it {
+		result.Set(k, v)
+	}
+	return result
+}
+
+// FilterMap applies a mapper that returns an optional Entry. Only "Value"
+// options are yielded, effectively filtering and transforming in one step.
+func (it It2[K, V]) FilterMap(mapper func(K, V) nilo.Option[Entry[K, V]]) It2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range it {
+			if m := mapper(k, v); m.IsValue() {
+				e := m.AsValue()
+				if !yield(e.Key, e.Value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// FlatMap applies a mapper that returns an It2 for each pair,
+// then flattens all resulting iterators into a single sequence.
+func (it It2[K, V]) FlatMap(mapper func(K, V) It2[K, V]) It2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range it {
+			for k2, v2 := range mapper(k, v) {
+				if !yield(k2, v2) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Skip returns an iterator that ignores the first n pairs.
+func (it It2[K, V]) Skip(n int) It2[K, V] {
+	return func(yield func(K, V) bool) {
+		count := 0
+		for k, v := range it {
+			if count < n {
+				count++
+				continue
+			}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// SkipWhile discards pairs until the predicate returns false,
+// then yields all remaining pairs.
+func (it It2[K, V]) SkipWhile(predicate func(K, V) bool) It2[K, V] {
+	return func(yield func(K, V) bool) {
+		dropping := true
+		for k, v := range it {
+			if dropping {
+				if predicate(k, v) {
+					continue
+				}
+				dropping = false
+			}
+
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile yields pairs as long as the predicate returns true.
+func (it It2[K, V]) TakeWhile(predicate func(K, V) bool) It2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range it {
+			if !predicate(k, v) {
+				return
+			}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// First returns the first pair as an Entry Option, or an empty Option
+// if the iterator is empty.
+func (it It2[K, V]) First() nilo.Option[Entry[K, V]] {
+	for k, v := range it {
+		return nilo.Value(Entry[K, V]{Key: k, Value: v})
+	}
+	return nilo.Nil[Entry[K, V]]()
+}
+
+// Find returns the first pair that satisfies the predicate.
+func (it It2[K, V]) Find(predicate func(K, V) bool) nilo.Option[Entry[K, V]] {
+	for k, v := range it {
+		if predicate(k, v) {
+			return nilo.Value(Entry[K, V]{Key: k, Value: v})
+		}
+	}
+	return nilo.Nil[Entry[K, V]]()
+}
+
+// Last returns the final pair of the iterator.
+func (it It2[K, V]) Last() nilo.Option[Entry[K, V]] {
+	found := false
+	var last Entry[K, V]
+	for k, v := range it {
+		last = Entry[K, V]{Key: k, Value: v}
+		found = true
+	}
+	if found {
+		return nilo.Value(last)
+	}
+	return nilo.Nil[Entry[K, V]]()
+}
+
+// Nth returns the pair at the given index, if it exists.
+func (it It2[K, V]) Nth(n int) nilo.Option[Entry[K, V]] {
+	if n < 0 {
+		return nilo.Nil[Entry[K, V]]()
+	}
+
+	count := 0
+	for k, v := range it {
+		if count == n {
+			return nilo.Value(Entry[K, V]{Key: k, Value: v})
+		}
+		count++
+	}
+	return nilo.Nil[Entry[K, V]]()
+}
+
+// Fold reduces the values to a single value using an accumulator that
+// also receives each key, starting with initValue and processing from
+// left to right.
+func (it It2[K, V]) Fold(initValue V, acc func(V, K, V) V) V {
+	result := initValue
+	for k, v := range it {
+		result = acc(result, k, v)
+	}
+	return result
+}
+
+// Partition splits the iterator into two collections: the pairs that
+// satisfy the predicate and those that do not. The original order is kept.
+func (it It2[K, V]) Partition(predicate func(K, V) bool) (It2[K, V], It2[K, V]) {
+	var pos []Entry[K, V]
+	var neg []Entry[K, V]
+	for k, v := range it {
+		if predicate(k, v) {
+			pos = append(pos, newEntry(k, v))
+		} else {
+			neg = append(neg, newEntry(k, v))
+		}
+	}
+	return fromEntries(pos), fromEntries(neg)
+}
+
+// Chain appends a second iterator to the current one.
+func (it It2[K, V]) Chain(i2 It2[K, V]) It2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range it {
+			if !yield(k, v) {
+				return
+			}
+		}
+		for k, v := range i2 {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Distinct returns an iterator that yields only the first pair for each
+// key. It uses a map to track seen keys, which requires O(N) memory.
+func (it It2[K, V]) Distinct() It2[K, V] {
+	return func(yield func(K, V) bool) {
+		seen := make(map[K]struct{})
+		for k, v := range it {
+			if _, exists := seen[k]; !exists {
+				seen[k] = struct{}{}
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Reverse returns an iterator that yields pairs in reverse order.
+// Note: This collects the entire sequence into memory first.
+func (it It2[K, V]) Reverse() It2[K, V] {
+	return func(yield func(K, V) bool) {
+		buf := it.CollectEntries()
+		for index := len(buf) - 1; index >= 0; index-- {
+			if !yield(buf[index].Key, buf[index].Value) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate pairs every key-value pair of the iterator with its 0-based
+// position, yielding the index and the pair as an Entry. It returns an
+// iter.Seq2 because an It2 method returning It2[int, Entry[K, V]] would be
+// an instantiation cycle; convert it with It2[int, Entry[K, V]](...) to
+// keep chaining.
+func (it It2[K, V]) Enumerate() iter.Seq2[int, Entry[K, V]] {
+	return func(yield func(int, Entry[K, V]) bool) {
+		index := 0
+		for k, v := range it {
+			if !yield(index, Entry[K, V]{Key: k, Value: v}) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// fromEntries creates an It2 that yields the entries of the slice in order.
+func fromEntries[K comparable, V any](entries []Entry[K, V]) It2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, e := range entries {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
 }
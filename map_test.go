@@ -2,8 +2,10 @@ package steams
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/javiorfo/nilo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -262,3 +264,157 @@ func TestMapCompare(t *testing.T) {
 	})
 	assert.False(t, min.IsValue(), "Expected not to find any key-value pair")
 }
+
+func orderedIt2() It2[string, int] {
+	return ZipToIt2(From("a", "b", "c", "d"), From(1, 2, 3, 4))
+}
+
+func TestMapFilterMap(t *testing.T) {
+	result := orderedIt2().FilterMap(func(k string, v int) nilo.Option[Entry[string, int]] {
+		if v%2 == 0 {
+			return nilo.Value(Entry[string, int]{k + k, v * 10})
+		}
+		return nilo.Nil[Entry[string, int]]()
+	})
+	assert.Equal(t, []Entry[string, int]{{"bb", 20}, {"dd", 40}}, result.CollectEntries())
+}
+
+func TestMapFlatMap(t *testing.T) {
+	result := orderedIt2().Take(2).FlatMap(func(k string, v int) It2[string, int] {
+		return ZipToIt2(From(k, k+"!"), From(v, -v))
+	})
+	assert.Equal(t, []Entry[string, int]{{"a", 1}, {"a!", -1}, {"b", 2}, {"b!", -2}}, result.CollectEntries())
+}
+
+func TestMapSkip(t *testing.T) {
+	assert.Equal(t, []Entry[string, int]{{"c", 3}, {"d", 4}}, orderedIt2().Skip(2).CollectEntries())
+	assert.Len(t, orderedIt2().Skip(10).CollectEntries(), 0)
+}
+
+func TestMapSkipWhileTakeWhile(t *testing.T) {
+	small := func(k string, v int) bool { return v < 3 }
+	assert.Equal(t, []Entry[string, int]{{"c", 3}, {"d", 4}}, orderedIt2().SkipWhile(small).CollectEntries())
+	assert.Equal(t, []Entry[string, int]{{"a", 1}, {"b", 2}}, orderedIt2().TakeWhile(small).CollectEntries())
+}
+
+func TestMapFirstFindLastNth(t *testing.T) {
+	assert.Equal(t, nilo.Value(Entry[string, int]{"a", 1}), orderedIt2().First())
+	assert.Equal(t, nilo.Value(Entry[string, int]{"c", 3}), orderedIt2().Find(func(k string, v int) bool { return v > 2 }))
+	assert.Equal(t, nilo.Value(Entry[string, int]{"d", 4}), orderedIt2().Last())
+	assert.Equal(t, nilo.Value(Entry[string, int]{"b", 2}), orderedIt2().Nth(1))
+
+	empty := FromMap(map[string]int{})
+	assert.True(t, empty.First().IsNil())
+	assert.True(t, orderedIt2().Find(func(k string, v int) bool { return v > 9 }).IsNil())
+	assert.True(t, empty.Last().IsNil())
+	assert.True(t, orderedIt2().Nth(4).IsNil())
+	assert.True(t, orderedIt2().Nth(-1).IsNil())
+}
+
+func TestMapFold(t *testing.T) {
+	total := orderedIt2().Fold(0, func(acc int, k string, v int) int {
+		if k == "b" {
+			return acc
+		}
+		return acc + v
+	})
+	assert.Equal(t, 8, total)
+}
+
+func TestMapPartition(t *testing.T) {
+	even, odd := orderedIt2().Partition(func(k string, v int) bool { return v%2 == 0 })
+	assert.Equal(t, []Entry[string, int]{{"b", 2}, {"d", 4}}, even.CollectEntries())
+	assert.Equal(t, []Entry[string, int]{{"a", 1}, {"c", 3}}, odd.CollectEntries())
+}
+
+func TestMapChain(t *testing.T) {
+	result := orderedIt2().Take(1).Chain(orderedIt2().Skip(3))
+	assert.Equal(t, []Entry[string, int]{{"a", 1}, {"d", 4}}, result.CollectEntries())
+}
+
+func TestMapDistinct(t *testing.T) {
+	it := ZipToIt2(From("a", "b", "a", "c", "b"), From(1, 2, 3, 4, 5))
+	assert.Equal(t, []Entry[string, int]{{"a", 1}, {"b", 2}, {"c", 4}}, it.Distinct().CollectEntries())
+}
+
+func TestMapReverse(t *testing.T) {
+	assert.Equal(t, []Entry[string, int]{{"d", 4}, {"c", 3}, {"b", 2}, {"a", 1}}, orderedIt2().Reverse().CollectEntries())
+	assert.Equal(t, []Entry[string, int]{{"d", 4}}, orderedIt2().Reverse().Take(1).CollectEntries())
+}
+
+func duplicatedIt2() It2[string, int] {
+	return ZipToIt2(From("a", "b", "a", "c", "a"), From(1, 2, 3, 4, 5))
+}
+
+func TestMapEnumerate(t *testing.T) {
+	it := ZipToIt2(From("a", "b"), From(1, 2))
+
+	var indexes []int
+	var pairs []Entry[string, int]
+	for i, e := range it.Enumerate() {
+		indexes = append(indexes, i)
+		pairs = append(pairs, e)
+	}
+	assert.Equal(t, []int{0, 1}, indexes)
+	assert.Equal(t, []Entry[string, int]{{"a", 1}, {"b", 2}}, pairs)
+
+	skipped := It2[int, Entry[string, int]](it.Enumerate()).Skip(1).Keys().Collect()
+	assert.Equal(t, []int{1}, skipped)
+}
+
+func TestMapCountDuplicates(t *testing.T) {
+	assert.Equal(t, 5, duplicatedIt2().Count())
+}
+
+func TestMapCollectEntries(t *testing.T) {
+	assert.Equal(t, []Entry[string, int]{{"a", 1}, {"b", 2}, {"a", 3}, {"c", 4}, {"a", 5}}, duplicatedIt2().CollectEntries())
+	assert.Len(t, FromMap(map[string]int{}).CollectEntries(), 0)
+}
+
+func TestMapCollectMulti(t *testing.T) {
+	assert.Equal(t, map[string][]int{"a": {1, 3, 5}, "b": {2}, "c": {4}}, duplicatedIt2().CollectMulti())
+}
+
+func TestMapCollectWithMerge(t *testing.T) {
+	sum := duplicatedIt2().CollectWithMerge(func(k string, a, b int) int { return a + b })
+	assert.Equal(t, map[string]int{"a": 9, "b": 2, "c": 4}, sum)
+
+	first := duplicatedIt2().CollectWithMerge(func(k string, a, b int) int { return a })
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 4}, first)
+}
+
+func TestMapCollectOrdered(t *testing.T) {
+	m := duplicatedIt2().CollectOrdered()
+	assert.Equal(t, []Entry[string, int]{{"a", 5}, {"b", 2}, {"c", 4}}, m.All().CollectEntries())
+}
+
+func TestMapSortByKeepsDuplicates(t *testing.T) {
+	sorted := duplicatedIt2().SortStableBy(func(a, b string) bool { return a > b })
+	assert.Equal(t, []Entry[string, int]{{"c", 4}, {"b", 2}, {"a", 1}, {"a", 3}, {"a", 5}}, sorted.CollectEntries())
+	assert.Equal(t, 5, duplicatedIt2().SortBy(func(a, b string) bool { return a < b }).Count())
+}
+
+func TestMapSortByValue(t *testing.T) {
+	scores := ZipToIt2(From("ann", "bob", "cid", "dan", "eve"), From(30, 50, 30, 50, 10))
+	desc := func(a, b int) int { return b - a }
+
+	leaderboard := scores.SortStableByValue(desc).CollectEntries()
+	assert.Equal(t, []Entry[string, int]{{"bob", 50}, {"dan", 50}, {"ann", 30}, {"cid", 30}, {"eve", 10}}, leaderboard)
+
+	values := scores.SortByValue(desc).Values().Collect()
+	assert.Equal(t, []int{50, 50, 30, 30, 10}, values)
+}
+
+func TestMapSortByEntry(t *testing.T) {
+	it := ZipToIt2(From("b", "a", "b", "a"), From(2, 2, 1, 1))
+	byKeyThenValue := func(x, y Entry[string, int]) int {
+		if x.Key != y.Key {
+			return strings.Compare(x.Key, y.Key)
+		}
+		return x.Value - y.Value
+	}
+	assert.Equal(t, []Entry[string, int]{{"a", 1}, {"a", 2}, {"b", 1}, {"b", 2}}, it.SortByEntry(byKeyThenValue).CollectEntries())
+
+	byKey := func(x, y Entry[string, int]) int { return strings.Compare(x.Key, y.Key) }
+	assert.Equal(t, []Entry[string, int]{{"a", 2}, {"a", 1}, {"b", 2}, {"b", 1}}, it.SortStableByEntry(byKey).CollectEntries())
+}
@@ -0,0 +1,116 @@
+package steams
+
+import (
+	"fmt"
+
+	"github.com/javiorfo/nilo"
+)
+
+// ChangeKind identifies the operation of a Change between two map snapshots.
+type ChangeKind int
+
+const (
+	// ChangeAdded means the key is only present in the after snapshot.
+	ChangeAdded ChangeKind = iota
+	// ChangeRemoved means the key is only present in the before snapshot.
+	ChangeRemoved
+	// ChangeUpdated means the key is present in both snapshots with
+	// different values.
+	ChangeUpdated
+)
+
+// String returns the name of the change kind.
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeAdded:
+		return "Added"
+	case ChangeRemoved:
+		return "Removed"
+	case ChangeUpdated:
+		return "Updated"
+	default:
+		return fmt.Sprintf("ChangeKind(%d)", int(k))
+	}
+}
+
+// Change describes how the entry for a key differs between two map
+// snapshots. Old is Nil for added keys and New is Nil for removed keys.
+type Change[K comparable, V any] struct {
+	Kind ChangeKind
+	Key  K
+	Old  nilo.Option[V]
+	New  nilo.Option[V]
+}
+
+// DiffMaps compares two key-value snapshots and yields a Change for every
+// key that was added, removed or whose value changed according to eq.
+// Added and updated keys are yielded in the order of after, followed by
+// removed keys in the order of before. If a key appears more than once in
+// a snapshot, its last value is used.
+// Note: both before and after are collected into memory before the first
+// change is yielded.
+func DiffMaps[K comparable, V any](before, after It2[K, V], eq func(V, V) bool) It[Change[K, V]] {
+	return func(yield func(Change[K, V]) bool) {
+		var order []K
+		previous := make(map[K]V)
+		for k, v := range before {
+			if _, exists := previous[k]; !exists {
+				order = append(order, k)
+			}
+			previous[k] = v
+		}
+
+		current := make(map[K]V)
+		var added []K
+		for k, v := range after {
+			if _, exists := current[k]; !exists {
+				added = append(added, k)
+			}
+			current[k] = v
+		}
+
+		for _, k := range added {
+			v := current[k]
+			old, exists := previous[k]
+			change := Change[K, V]{Kind: ChangeAdded, Key: k, Old: nilo.Nil[V](), New: nilo.Value(v)}
+			if exists {
+				if eq(old, v) {
+					continue
+				}
+				change.Kind = ChangeUpdated
+				change.Old = nilo.Value(old)
+			}
+			if !yield(change) {
+				return
+			}
+		}
+
+		for _, k := range order {
+			if _, exists := current[k]; exists {
+				continue
+			}
+			change := Change[K, V]{Kind: ChangeRemoved, Key: k, Old: nilo.Value(previous[k]), New: nilo.Nil[V]()}
+			if !yield(change) {
+				return
+			}
+		}
+	}
+}
+
+// ApplyChanges replays a stream of changes onto target: added and updated
+// keys are set to their new value and removed keys are deleted. It returns
+// target, or a new map if target is nil.
+func ApplyChanges[K comparable, V any](target map[K]V, changes It[Change[K, V]]) map[K]V {
+	if target == nil {
+		target = make(map[K]V)
+	}
+	for c := range changes {
+		switch c.Kind {
+		case ChangeAdded, ChangeUpdated:
+			target[c.Key] = c.New.AsValue()
+		case ChangeRemoved:
+			delete(target, c.Key)
+		}
+	}
+	return target
+}
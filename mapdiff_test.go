@@ -0,0 +1,40 @@
+package steams
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/javiorfo/nilo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffMaps(t *testing.T) {
+	before := ZipToIt2(From("host", "port", "debug"), From("a", "80", "true"))
+	after := ZipToIt2(From("host", "port", "timeout"), From("a", "8080", "30"))
+	eq := func(a, b string) bool { return a == b }
+
+	changes := DiffMaps(before, after, eq).Collect()
+	assert.Equal(t, []Change[string, string]{
+		{Kind: ChangeUpdated, Key: "port", Old: nilo.Value("80"), New: nilo.Value("8080")},
+		{Kind: ChangeAdded, Key: "timeout", Old: nilo.Nil[string](), New: nilo.Value("30")},
+		{Kind: ChangeRemoved, Key: "debug", Old: nilo.Value("true"), New: nilo.Nil[string]()},
+	}, changes)
+
+	assert.Len(t, DiffMaps(before, before, eq).Collect(), 0)
+	assert.Len(t, DiffMaps(before, after, eq).Take(1).Collect(), 1)
+}
+
+func TestApplyChanges(t *testing.T) {
+	before := map[string]int{"a": 1, "b": 2, "c": 3}
+	after := map[string]int{"a": 1, "b": 20, "d": 4}
+	eq := func(a, b int) bool { return a == b }
+
+	changes := DiffMaps(FromMap(before), FromMap(after), eq)
+	assert.Equal(t, after, ApplyChanges(maps.Clone(before), changes))
+	assert.Equal(t, map[string]int{"b": 20, "d": 4}, ApplyChanges(nil, changes))
+}
+
+func TestChangeKindString(t *testing.T) {
+	assert.Equal(t, "Updated", ChangeUpdated.String())
+	assert.Equal(t, "ChangeKind(7)", ChangeKind(7).String())
+}
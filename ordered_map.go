@@ -0,0 +1,133 @@
+package steams
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/javiorfo/nilo"
+)
+
+// OrderedMap is a map that remembers the insertion order of its keys.
+// Iteration and JSON encoding follow that order.
+// The zero value is an empty map ready to use.
+// An OrderedMap is not safe for concurrent use.
+type OrderedMap[K comparable, V any] struct {
+	keys   []K
+	values map[K]V
+}
+
+// NewOrderedMap creates an empty OrderedMap.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{values: make(map[K]V)}
+}
+
+// Set stores the value for the key. A new key is appended at the end;
+// an existing key keeps its position.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if m.values == nil {
+		m.values = make(map[K]V)
+	}
+	if _, exists := m.values[key]; !exists {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+// Get returns the value stored for the key, if any.
+func (m *OrderedMap[K, V]) Get(key K) nilo.Option[V] {
+	if v, exists := m.values[key]; exists {
+		return nilo.Value(v)
+	}
+	return nilo.Nil[V]()
+}
+
+// Delete removes the key from the map. It runs in O(N) time, since the
+// key has to be removed from the ordering.
+func (m *OrderedMap[K, V]) Delete(key K) {
+	if _, exists := m.values[key]; !exists {
+		return
+	}
+	delete(m.values, key)
+	m.keys = slices.DeleteFunc(m.keys, func(k K) bool { return k == key })
+}
+
+// Len returns the number of keys in the map.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.keys)
+}
+
+// All returns an iterator over the pairs of the map in insertion order.
+func (m *OrderedMap[K, V]) All() It2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range m.keys {
+			if !yield(k, m.values[k]) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the keys of the map in insertion order.
+func (m *OrderedMap[K, V]) Keys() It[K] {
+	return func(yield func(K) bool) {
+		for _, k := range m.keys {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of the map in insertion order.
+func (m *OrderedMap[K, V]) Values() It[V] {
+	return m.All().Values()
+}
+
+// MarshalJSON encodes the map as a JSON object whose members follow the
+// insertion order. Keys are encoded like encoding/json does for maps:
+// strings as is, encoding.TextMarshaler implementations with MarshalText
+// and any other type with its default format.
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for idx, k := range m.keys {
+		if idx > 0 {
+			buf.WriteByte(',')
+		}
+
+		name, err := jsonKey(k)
+		if err != nil {
+			return nil, err
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(m.values[k])
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// jsonKey returns the JSON object member name used for a map key.
+func jsonKey(key any) (string, error) {
+	switch k := key.(type) {
+	case string:
+		return k, nil
+	case encoding.TextMarshaler:
+		text, err := k.MarshalText()
+		return string(text), err
+	default:
+		return fmt.Sprint(k), nil
+	}
+}
@@ -0,0 +1,77 @@
+package steams
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/javiorfo/nilo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedMap(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Set("c", 3)
+	m.Set("b", 10)
+
+	assert.Equal(t, 3, m.Len())
+	assert.Equal(t, nilo.Value(10), m.Get("b"))
+	assert.True(t, m.Get("z").IsNil())
+	assert.Equal(t, []string{"b", "a", "c"}, m.Keys().Collect())
+	assert.Equal(t, []int{10, 2, 3}, m.Values().Collect())
+
+	m.Delete("a")
+	m.Delete("z")
+	assert.Equal(t, []Entry[string, int]{{"b", 10}, {"c", 3}}, m.All().CollectEntries())
+}
+
+func TestOrderedMapZeroValue(t *testing.T) {
+	var m OrderedMap[string, int]
+	assert.True(t, m.Get("a").IsNil())
+	m.Delete("a")
+
+	m.Set("a", 1)
+	assert.Equal(t, nilo.Value(1), m.Get("a"))
+	assert.Equal(t, 1, m.Len())
+}
+
+func TestOrderedMapKeysAfterDelete(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	keys := m.Keys()
+	m.Delete("a")
+	assert.Equal(t, []string{"b", "c"}, keys.Collect())
+	assert.Equal(t, []int{2, 3}, m.Values().Collect())
+}
+
+func TestOrderedMapMarshalJSON(t *testing.T) {
+	m := FromMap(map[string]int{"zeta": 1, "alpha": 2, "mid": 3}).
+		SortBy(func(a, b string) bool { return a < b }).
+		CollectOrdered()
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"alpha":2,"mid":3,"zeta":1}`, string(data))
+
+	numbers := NewOrderedMap[int, []string]()
+	numbers.Set(2, []string{"b"})
+	numbers.Set(1, nil)
+	data, err = json.Marshal(numbers)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"2":["b"],"1":null}`, string(data))
+
+	addrs := NewOrderedMap[netip.Addr, bool]()
+	addrs.Set(netip.MustParseAddr("10.0.0.1"), true)
+	data, err = json.Marshal(addrs)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"10.0.0.1":true}`, string(data))
+
+	data, err = json.Marshal(NewOrderedMap[string, int]())
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, string(data))
+}
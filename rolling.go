@@ -0,0 +1,216 @@
+package steams
+
+import "math"
+
+// WindowMode controls whether rolling aggregates are emitted while the
+// window is still filling up.
+type WindowMode int
+
+const (
+	// FullWindows emits a value only once the window holds size elements.
+	FullWindows WindowMode = iota
+	// PartialWindows also emits values for the first size-1 elements,
+	// aggregating over the elements seen so far.
+	PartialWindows
+)
+
+// emits reports whether a window holding count elements should be emitted.
+func (m WindowMode) emits(count, size int) bool {
+	return m == PartialWindows || count >= size
+}
+
+// ring is a fixed-capacity circular buffer holding the current window.
+type ring[T any] struct {
+	buf   []T
+	head  int
+	count int
+}
+
+// newRing creates an empty ring holding at most size elements.
+func newRing[T any](size int) *ring[T] {
+	return &ring[T]{buf: make([]T, size)}
+}
+
+// push appends v and returns the evicted element, if the ring was full.
+func (r *ring[T]) push(v T) (T, bool) {
+	var evicted T
+	full := r.count == len(r.buf)
+	if full {
+		evicted = r.buf[r.head]
+	} else {
+		r.count++
+	}
+	r.buf[r.head] = v
+	r.head = (r.head + 1) % len(r.buf)
+	return evicted, full
+}
+
+// RollingSum returns an iterator yielding the sum of the last size elements
+// for each element of the input. Each step costs O(1).
+func RollingSum[T Number](i It[T], size int, mode WindowMode) It[T] {
+	return func(yield func(T) bool) {
+		if size <= 0 {
+			return
+		}
+		window := newRing[T](size)
+		var sum T
+		for v := range i {
+			if old, evicted := window.push(v); evicted {
+				sum -= old
+			}
+			sum += v
+			if mode.emits(window.count, size) && !yield(sum) {
+				return
+			}
+		}
+	}
+}
+
+// RollingMean returns an iterator yielding the arithmetic mean of the last
+// size elements for each element of the input. Each step costs O(1).
+func RollingMean[T Number](i It[T], size int, mode WindowMode) It[float64] {
+	return func(yield func(float64) bool) {
+		if size <= 0 {
+			return
+		}
+		window := newRing[T](size)
+		var sum float64
+		for v := range i {
+			if old, evicted := window.push(v); evicted {
+				sum -= float64(old)
+			}
+			sum += float64(v)
+			if mode.emits(window.count, size) && !yield(sum/float64(window.count)) {
+				return
+			}
+		}
+	}
+}
+
+// RollingMin returns an iterator yielding the minimum of the last size
+// elements for each element of the input. It keeps a monotonic deque, so
+// each step costs amortized O(1).
+func RollingMin[T Ordered](i It[T], size int, mode WindowMode) It[T] {
+	return rollingExtreme(i, size, mode, func(a, b T) bool { return a <= b })
+}
+
+// RollingMax returns an iterator yielding the maximum of the last size
+// elements for each element of the input. It keeps a monotonic deque, so
+// each step costs amortized O(1).
+func RollingMax[T Ordered](i It[T], size int, mode WindowMode) It[T] {
+	return rollingExtreme(i, size, mode, func(a, b T) bool { return a >= b })
+}
+
+// rollingExtreme keeps a deque of candidates in which every element beats
+// the ones after it; the front is always the extreme of the window.
+func rollingExtreme[T Ordered](i It[T], size int, mode WindowMode, beats func(T, T) bool) It[T] {
+	type candidate struct {
+		index int
+		value T
+	}
+
+	return func(yield func(T) bool) {
+		if size <= 0 {
+			return
+		}
+		var deque []candidate
+		index := 0
+		for v := range i {
+			for len(deque) > 0 && beats(v, deque[len(deque)-1].value) {
+				deque = deque[:len(deque)-1]
+			}
+			deque = append(deque, candidate{index, v})
+			if deque[0].index <= index-size {
+				deque = deque[1:]
+			}
+			index++
+			if mode.emits(index, size) && !yield(deque[0].value) {
+				return
+			}
+		}
+	}
+}
+
+// RollingStdDev returns an iterator yielding the population standard
+// deviation of the last size elements for each element of the input.
+// It uses Welford's algorithm with removal, so each step costs O(1).
+func RollingStdDev[T Number](i It[T], size int, mode WindowMode) It[float64] {
+	return func(yield func(float64) bool) {
+		if size <= 0 {
+			return
+		}
+		window := newRing[T](size)
+		var mean, m2 float64
+		for v := range i {
+			if old, evicted := window.push(v); evicted {
+				if n := float64(window.count - 1); n == 0 {
+					mean, m2 = 0, 0
+				} else {
+					x := float64(old)
+					delta := x - mean
+					mean -= delta / n
+					m2 -= delta * (x - mean)
+				}
+			}
+
+			x := float64(v)
+			n := float64(window.count)
+			delta := x - mean
+			mean += delta / n
+			m2 += delta * (x - mean)
+
+			if mode.emits(window.count, size) && !yield(math.Sqrt(math.Max(m2, 0)/n)) {
+				return
+			}
+		}
+	}
+}
+
+// EMA returns an iterator yielding the exponential moving average of the
+// input with smoothing factor alpha, where values close to 1 favor recent
+// elements. The first element seeds the average.
+func EMA[T Number](i It[T], alpha float64) It[float64] {
+	return func(yield func(float64) bool) {
+		var avg float64
+		first := true
+		for v := range i {
+			if first {
+				avg = float64(v)
+				first = false
+			} else {
+				avg = alpha*float64(v) + (1-alpha)*avg
+			}
+			if !yield(avg) {
+				return
+			}
+		}
+	}
+}
+
+// WMA returns an iterator yielding the linearly weighted moving average of
+// the last size elements, where the most recent element has weight size
+// and the oldest has weight 1. Each step costs O(1).
+func WMA[T Number](i It[T], size int, mode WindowMode) It[float64] {
+	return func(yield func(float64) bool) {
+		if size <= 0 {
+			return
+		}
+		window := newRing[T](size)
+		var total, numerator float64
+		for v := range i {
+			x := float64(v)
+			if old, evicted := window.push(v); evicted {
+				numerator += float64(size)*x - total
+				total += x - float64(old)
+			} else {
+				numerator += float64(window.count) * x
+				total += x
+			}
+
+			n := float64(window.count)
+			if mode.emits(window.count, size) && !yield(numerator/(n*(n+1)/2)) {
+				return
+			}
+		}
+	}
+}
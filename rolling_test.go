@@ -0,0 +1,57 @@
+package steams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollingSum(t *testing.T) {
+	input := From(1, 2, 3, 4, 5)
+	assert.Equal(t, []int{6, 9, 12}, RollingSum(input, 3, FullWindows).Collect())
+	assert.Equal(t, []int{1, 3, 6, 9, 12}, RollingSum(input, 3, PartialWindows).Collect())
+	assert.Equal(t, []int{6}, RollingSum(input, 3, FullWindows).Take(1).Collect())
+
+	var empty []int
+	assert.Equal(t, empty, RollingSum(input, 6, FullWindows).Collect())
+	assert.Equal(t, empty, RollingSum(input, 0, PartialWindows).Collect())
+}
+
+func TestRollingMean(t *testing.T) {
+	input := From(2, 4, 6, 8)
+	assert.Equal(t, []float64{3, 5, 7}, RollingMean(input, 2, FullWindows).Collect())
+	assert.Equal(t, []float64{2, 3, 4, 6}, RollingMean(input, 3, PartialWindows).Collect())
+}
+
+func TestRollingMinMax(t *testing.T) {
+	input := From(4, 2, 12, 3, 8, 1, 7)
+	assert.Equal(t, []int{2, 2, 3, 1, 1}, RollingMin(input, 3, FullWindows).Collect())
+	assert.Equal(t, []int{12, 12, 12, 8, 8}, RollingMax(input, 3, FullWindows).Collect())
+	assert.Equal(t, []int{4, 2, 2, 2, 3, 1, 1}, RollingMin(input, 3, PartialWindows).Collect())
+	assert.Equal(t, []string{"b", "c", "c"}, RollingMax(From("a", "b", "c", "a"), 2, FullWindows).Collect())
+}
+
+func TestRollingStdDev(t *testing.T) {
+	input := From(2, 4, 4, 4, 5, 5, 7, 9)
+	result := RollingStdDev(input, 8, FullWindows).Collect()
+	assert.Len(t, result, 1)
+	assert.InDelta(t, 2.0, result[0], 1e-9)
+
+	result = RollingStdDev(From(1, 3, 5, 5), 2, PartialWindows).Collect()
+	assert.InDeltaSlice(t, []float64{0, 1, 1, 0}, result, 1e-9)
+
+	assert.Equal(t, []float64{0, 0, 0}, RollingStdDev(From(1, 5, 9), 1, FullWindows).Collect())
+}
+
+func TestEMA(t *testing.T) {
+	result := EMA(From(10, 20, 30), 0.5).Collect()
+	assert.InDeltaSlice(t, []float64{10, 15, 22.5}, result, 1e-9)
+}
+
+func TestWMA(t *testing.T) {
+	input := From(1, 2, 3, 4)
+	// (1*1 + 2*2 + 3*3) / 6 and (2*1 + 3*2 + 4*3) / 6
+	assert.InDeltaSlice(t, []float64{14.0 / 6, 20.0 / 6}, WMA(input, 3, FullWindows).Collect(), 1e-9)
+	// 1, (1 + 2*2) / 3, then full windows
+	assert.InDeltaSlice(t, []float64{1, 5.0 / 3, 14.0 / 6, 20.0 / 6}, WMA(input, 3, PartialWindows).Collect(), 1e-9)
+}
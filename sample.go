@@ -0,0 +1,152 @@
+package steams
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// SeededRand returns a random generator initialized with the given seed.
+// Passing the same seed to the sampling functions produces the same output,
+// which is useful for tests and reproducible analyses.
+func SeededRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// SampleReservoir returns an iterator that yields a uniform random sample
+// of at most k elements using reservoir sampling (Algorithm L). Only k
+// elements are kept in memory, but the whole input is consumed before the
+// first element is yielded. If rng is nil, a randomly seeded generator is used.
+func SampleReservoir[T any](i It[T], k int, rng *rand.Rand) It[T] {
+	return func(yield func(T) bool) {
+		if k <= 0 {
+			return
+		}
+		rng := randOrDefault(rng)
+
+		reservoir := make([]T, 0, k)
+		weight := 1.0
+		next := 0
+		index := 0
+		for v := range i {
+			switch {
+			case index < k:
+				reservoir = append(reservoir, v)
+				if index == k-1 {
+					weight = math.Exp(math.Log(unitRand(rng)) / float64(k))
+					next = nextReplacement(rng, weight, index)
+				}
+			case index == next:
+				reservoir[rng.IntN(k)] = v
+				weight *= math.Exp(math.Log(unitRand(rng)) / float64(k))
+				next = nextReplacement(rng, weight, index)
+			}
+			index++
+		}
+
+		for _, v := range reservoir {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SampleBernoulli returns an iterator that yields each element independently
+// with probability p. It is lazy and requires O(1) memory.
+// If rng is nil, a randomly seeded generator is used.
+func SampleBernoulli[T any](i It[T], p float64, rng *rand.Rand) It[T] {
+	return func(yield func(T) bool) {
+		rng := randOrDefault(rng)
+		for v := range i {
+			if rng.Float64() < p {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// SampleStratified groups the elements with the classifier function and
+// draws a uniform random sample of at most perGroupK elements from each
+// group. Groups are yielded in order of first appearance.
+// Note: This consumes the entire input before yielding.
+// If rng is nil, a randomly seeded generator is used.
+func SampleStratified[K comparable, T any](i It[T], classifier func(T) K, perGroupK int, rng *rand.Rand) It2[K, It[T]] {
+	return func(yield func(K, It[T]) bool) {
+		if perGroupK <= 0 {
+			return
+		}
+		rng := randOrDefault(rng)
+
+		var keys []K
+		reservoirs := make(map[K][]T)
+		seen := make(map[K]int)
+		for v := range i {
+			key := classifier(v)
+			count, exists := seen[key]
+			if !exists {
+				keys = append(keys, key)
+			}
+			seen[key] = count + 1
+
+			if count < perGroupK {
+				reservoirs[key] = append(reservoirs[key], v)
+			} else if j := rng.IntN(count + 1); j < perGroupK {
+				reservoirs[key][j] = v
+			}
+		}
+
+		for _, key := range keys {
+			if !yield(key, FromSlice(reservoirs[key])) {
+				return
+			}
+		}
+	}
+}
+
+// Shuffle returns an iterator that yields the elements in a random order.
+// Note: This collects the entire sequence into memory first.
+// If rng is nil, a randomly seeded generator is used.
+func Shuffle[T any](i It[T], rng *rand.Rand) It[T] {
+	return func(yield func(T) bool) {
+		buf := i.Collect()
+		randOrDefault(rng).Shuffle(len(buf), func(a, b int) {
+			buf[a], buf[b] = buf[b], buf[a]
+		})
+
+		for _, v := range buf {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// randOrDefault returns rng, or a randomly seeded generator if it is nil.
+func randOrDefault(rng *rand.Rand) *rand.Rand {
+	if rng == nil {
+		return SeededRand(rand.Uint64())
+	}
+	return rng
+}
+
+// unitRand returns a random number in the interval (0, 1].
+func unitRand(rng *rand.Rand) float64 {
+	return 1 - rng.Float64()
+}
+
+// nextReplacement returns the index of the next element Algorithm L puts
+// into the reservoir, after skipping a random number of elements following
+// index. It saturates at math.MaxInt instead of overflowing, which only
+// happens for skips longer than any stream can be.
+func nextReplacement(rng *rand.Rand, weight float64, index int) int {
+	skip := math.Floor(math.Log(unitRand(rng)) / math.Log1p(-weight))
+	if math.IsInf(skip, 0) || math.IsNaN(skip) || skip >= math.MaxInt {
+		return math.MaxInt
+	}
+	if n := int(skip); n < math.MaxInt-index {
+		return index + n + 1
+	}
+	return math.MaxInt
+}
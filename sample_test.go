@@ -0,0 +1,103 @@
+package steams
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rangeSlice(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func TestSampleReservoir(t *testing.T) {
+	input := FromSlice(rangeSlice(1000))
+
+	sample := SampleReservoir(input, 10, SeededRand(42)).Collect()
+	assert.Len(t, sample, 10)
+	assert.Len(t, Distinct(FromSlice(sample)).Collect(), 10)
+	assert.Equal(t, sample, SampleReservoir(input, 10, SeededRand(42)).Collect())
+
+	assert.Equal(t, []int{1, 2}, SampleReservoir(From(1, 2), 5, SeededRand(1)).Collect())
+
+	var empty []int
+	assert.Equal(t, empty, SampleReservoir(input, 0, SeededRand(1)).Collect())
+}
+
+func TestSampleReservoirUniform(t *testing.T) {
+	rng := SeededRand(7)
+	counts := make([]int, 20)
+	for range 5000 {
+		for v := range SampleReservoir(FromSlice(rangeSlice(20)), 5, rng) {
+			counts[v]++
+		}
+	}
+	// Each element is expected 1250 times.
+	for _, c := range counts {
+		assert.InDelta(t, 1250, c, 150)
+	}
+}
+
+func TestNextReplacementLongSkips(t *testing.T) {
+	rng := SeededRand(7)
+	long := 0
+	for range 100 {
+		if nextReplacement(rng, 1e-15, 0) > math.MaxInt32 {
+			long++
+		}
+	}
+	assert.Greater(t, long, 90, "skips beyond 2^31 must not be capped")
+
+	assert.Equal(t, math.MaxInt, nextReplacement(rng, 1e-300, math.MaxInt-10))
+	assert.Equal(t, math.MaxInt, nextReplacement(rng, 0, 0))
+}
+
+func TestSampleBernoulli(t *testing.T) {
+	input := FromSlice(rangeSlice(10000))
+
+	sample := SampleBernoulli(input, 0.1, SeededRand(3)).Collect()
+	assert.InDelta(t, 1000, len(sample), 150)
+	assert.True(t, slices.IsSorted(sample))
+	assert.Equal(t, sample, SampleBernoulli(input, 0.1, SeededRand(3)).Collect())
+
+	assert.Len(t, SampleBernoulli(input, 0, nil).Collect(), 0)
+	assert.Len(t, SampleBernoulli(input, 1, nil).Collect(), 10000)
+}
+
+func TestSampleStratified(t *testing.T) {
+	input := FromSlice(rangeSlice(100))
+	parity := func(i int) string {
+		if i%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	var keys []string
+	for k, group := range SampleStratified(input, parity, 3, SeededRand(5)) {
+		keys = append(keys, k)
+		values := group.Collect()
+		assert.Len(t, values, 3)
+		assert.True(t, group.All(func(i int) bool { return parity(i) == k }))
+	}
+	assert.Equal(t, []string{"even", "odd"}, keys)
+
+	first := SampleStratified(input, parity, 3, SeededRand(5)).Values().Collect()
+	second := SampleStratified(input, parity, 3, SeededRand(5)).Values().Collect()
+	assert.Equal(t, first[0].Collect(), second[0].Collect())
+}
+
+func TestShuffle(t *testing.T) {
+	input := FromSlice(rangeSlice(50))
+
+	shuffled := Shuffle(input, SeededRand(9)).Collect()
+	assert.NotEqual(t, input.Collect(), shuffled)
+	assert.Equal(t, shuffled, Shuffle(input, SeededRand(9)).Collect())
+	assert.ElementsMatch(t, input.Collect(), shuffled)
+}
@@ -0,0 +1,197 @@
+package steams
+
+import "iter"
+
+// Union returns an iterator that yields every distinct element found in
+// either i1 or i2, in order of first appearance (elements of i1 first).
+// It uses set semantics: duplicates are yielded only once. It uses a map
+// to track seen elements, which requires O(N) memory.
+func Union[T comparable](i1, i2 It[T]) It[T] {
+	return UnionBy(i1, i2, identity[T])
+}
+
+// UnionBy is a Union variant that compares elements by the key returned
+// from keyFunc. The first element seen for each key is the one yielded.
+func UnionBy[T any, K comparable](i1, i2 It[T], keyFunc func(T) K) It[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[K]struct{})
+		for v := range i1.Chain(i2) {
+			key := keyFunc(v)
+			if _, exists := seen[key]; !exists {
+				seen[key] = struct{}{}
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Intersect returns an iterator that yields the distinct elements of i1
+// that are also present in i2, in the order they appear in i1.
+// It uses set semantics. Note: i2 is collected into a set before the
+// first element is yielded.
+func Intersect[T comparable](i1, i2 It[T]) It[T] {
+	return IntersectBy(i1, i2, identity[T])
+}
+
+// IntersectBy is an Intersect variant that compares elements by the key
+// returned from keyFunc.
+func IntersectBy[T any, K comparable](i1, i2 It[T], keyFunc func(T) K) It[T] {
+	return func(yield func(T) bool) {
+		other := keySet(i2, keyFunc)
+		seen := make(map[K]struct{})
+		for v := range i1 {
+			key := keyFunc(v)
+			if _, exists := other[key]; !exists {
+				continue
+			}
+			if _, exists := seen[key]; !exists {
+				seen[key] = struct{}{}
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Except returns an iterator that yields the distinct elements of i1
+// that are not present in i2, in the order they appear in i1.
+// It uses set semantics. Note: i2 is collected into a set before the
+// first element is yielded.
+func Except[T comparable](i1, i2 It[T]) It[T] {
+	return ExceptBy(i1, i2, identity[T])
+}
+
+// ExceptBy is an Except variant that compares elements by the key
+// returned from keyFunc.
+func ExceptBy[T any, K comparable](i1, i2 It[T], keyFunc func(T) K) It[T] {
+	return func(yield func(T) bool) {
+		seen := keySet(i2, keyFunc)
+		for v := range i1 {
+			key := keyFunc(v)
+			if _, exists := seen[key]; !exists {
+				seen[key] = struct{}{}
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// SymmetricDiff returns an iterator that yields the distinct elements
+// present in exactly one of i1 or i2. Elements only in i1 are yielded
+// first, followed by elements only in i2. It uses set semantics.
+// Note: both iterators are collected into memory.
+func SymmetricDiff[T comparable](i1, i2 It[T]) It[T] {
+	return SymmetricDiffBy(i1, i2, identity[T])
+}
+
+// SymmetricDiffBy is a SymmetricDiff variant that compares elements by
+// the key returned from keyFunc.
+func SymmetricDiffBy[T any, K comparable](i1, i2 It[T], keyFunc func(T) K) It[T] {
+	return func(yield func(T) bool) {
+		left := FromSlice(i1.Collect())
+		right := FromSlice(i2.Collect())
+		for v := range ExceptBy(left, right, keyFunc).Chain(ExceptBy(right, left, keyFunc)) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// UnionSorted merges two iterators that are both sorted according to cmp
+// and yields their union in sorted order. It streams in O(1) memory.
+// It uses multiset (bag) semantics: an element occurring m times in i1
+// and n times in i2 is yielded max(m, n) times.
+func UnionSorted[T any](i1, i2 It[T], cmp func(T, T) int) It[T] {
+	return mergeSorted(i1, i2, cmp, true, true, true)
+}
+
+// IntersectSorted yields the elements common to two iterators that are
+// both sorted according to cmp. It streams in O(1) memory.
+// It uses multiset (bag) semantics: an element occurring m times in i1
+// and n times in i2 is yielded min(m, n) times.
+func IntersectSorted[T any](i1, i2 It[T], cmp func(T, T) int) It[T] {
+	return mergeSorted(i1, i2, cmp, false, false, true)
+}
+
+// ExceptSorted yields the elements of i1 not matched in i2, where both
+// iterators are sorted according to cmp. It streams in O(1) memory.
+// It uses multiset (bag) semantics: an element occurring m times in i1
+// and n times in i2 is yielded max(m-n, 0) times.
+func ExceptSorted[T any](i1, i2 It[T], cmp func(T, T) int) It[T] {
+	return mergeSorted(i1, i2, cmp, true, false, false)
+}
+
+// SymmetricDiffSorted yields the elements not matched between two
+// iterators that are both sorted according to cmp, in sorted order.
+// It streams in O(1) memory. It uses multiset (bag) semantics: an element
+// occurring m times in i1 and n times in i2 is yielded |m-n| times.
+func SymmetricDiffSorted[T any](i1, i2 It[T], cmp func(T, T) int) It[T] {
+	return mergeSorted(i1, i2, cmp, true, true, false)
+}
+
+// mergeSorted walks two sorted iterators in lockstep. Elements only
+// present on the left, only on the right or on both sides are yielded
+// according to the corresponding flags.
+func mergeSorted[T any](i1, i2 It[T], cmp func(T, T) int, onlyLeft, onlyRight, both bool) It[T] {
+	return func(yield func(T) bool) {
+		next1, stop1 := iter.Pull(iter.Seq[T](i1))
+		defer stop1()
+		next2, stop2 := iter.Pull(iter.Seq[T](i2))
+		defer stop2()
+
+		val1, ok1 := next1()
+		val2, ok2 := next2()
+
+		for ok1 && ok2 {
+			switch c := cmp(val1, val2); {
+			case c < 0:
+				if onlyLeft && !yield(val1) {
+					return
+				}
+				val1, ok1 = next1()
+			case c > 0:
+				if onlyRight && !yield(val2) {
+					return
+				}
+				val2, ok2 = next2()
+			default:
+				if both && !yield(val1) {
+					return
+				}
+				val1, ok1 = next1()
+				val2, ok2 = next2()
+			}
+		}
+
+		for ; ok1 && onlyLeft; val1, ok1 = next1() {
+			if !yield(val1) {
+				return
+			}
+		}
+		for ; ok2 && onlyRight; val2, ok2 = next2() {
+			if !yield(val2) {
+				return
+			}
+		}
+	}
+}
+
+// keySet collects the keys of every element of the iterator into a set.
+func keySet[T any, K comparable](i It[T], keyFunc func(T) K) map[K]struct{} {
+	set := make(map[K]struct{})
+	for v := range i {
+		set[keyFunc(v)] = struct{}{}
+	}
+	return set
+}
+
+// identity returns its argument unchanged.
+func identity[T any](v T) T {
+	return v
+}
@@ -0,0 +1,81 @@
+package steams
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type setItem struct {
+	ID   int
+	Name string
+}
+
+func TestUnion(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, Union(From(1, 2, 2, 3), From(3, 4, 1, 5)).Collect())
+
+	var empty []int
+	assert.Equal(t, empty, Union(From[int](), From[int]()).Collect())
+	assert.Equal(t, []int{1, 2}, Union(From(1, 2, 3), From(4)).Take(2).Collect())
+}
+
+func TestUnionBy(t *testing.T) {
+	a := From(setItem{1, "a"}, setItem{2, "b"})
+	b := From(setItem{2, "other"}, setItem{3, "c"})
+	result := UnionBy(a, b, func(s setItem) int { return s.ID }).Collect()
+	assert.Equal(t, []setItem{{1, "a"}, {2, "b"}, {3, "c"}}, result)
+}
+
+func TestIntersect(t *testing.T) {
+	assert.Equal(t, []int{2, 3}, Intersect(From(1, 2, 2, 3, 4), From(3, 2, 5)).Collect())
+
+	var empty []int
+	assert.Equal(t, empty, Intersect(From(1, 2), From(3, 4)).Collect())
+}
+
+func TestIntersectBy(t *testing.T) {
+	a := From(setItem{1, "a"}, setItem{2, "b"}, setItem{3, "c"})
+	b := From(setItem{3, "x"}, setItem{1, "y"})
+	result := IntersectBy(a, b, func(s setItem) int { return s.ID }).Collect()
+	assert.Equal(t, []setItem{{1, "a"}, {3, "c"}}, result)
+}
+
+func TestExcept(t *testing.T) {
+	assert.Equal(t, []int{1, 4}, Except(From(1, 2, 1, 3, 4), From(2, 3)).Collect())
+	assert.Equal(t, []int{1, 2}, Except(From(1, 2), From[int]()).Collect())
+}
+
+func TestExceptBy(t *testing.T) {
+	a := From(setItem{1, "a"}, setItem{2, "b"}, setItem{3, "c"})
+	b := From(setItem{2, "x"})
+	result := ExceptBy(a, b, func(s setItem) int { return s.ID }).Collect()
+	assert.Equal(t, []setItem{{1, "a"}, {3, "c"}}, result)
+}
+
+func TestSymmetricDiff(t *testing.T) {
+	assert.Equal(t, []int{1, 4, 5}, SymmetricDiff(From(1, 2, 3, 1), From(3, 2, 4, 5, 4)).Collect())
+}
+
+func TestSymmetricDiffBy(t *testing.T) {
+	a := From(setItem{1, "a"}, setItem{2, "b"})
+	b := From(setItem{2, "x"}, setItem{3, "c"})
+	result := SymmetricDiffBy(a, b, func(s setItem) int { return s.ID }).Collect()
+	assert.Equal(t, []setItem{{1, "a"}, {3, "c"}}, result)
+}
+
+func TestSortedSetOperations(t *testing.T) {
+	a := From(1, 1, 2, 4, 4, 4, 6)
+	b := From(1, 3, 4, 4, 7)
+
+	assert.Equal(t, []int{1, 1, 2, 3, 4, 4, 4, 6, 7}, UnionSorted(a, b, cmp.Compare[int]).Collect())
+	assert.Equal(t, []int{1, 4, 4}, IntersectSorted(a, b, cmp.Compare[int]).Collect())
+	assert.Equal(t, []int{1, 2, 4, 6}, ExceptSorted(a, b, cmp.Compare[int]).Collect())
+	assert.Equal(t, []int{1, 2, 3, 4, 6, 7}, SymmetricDiffSorted(a, b, cmp.Compare[int]).Collect())
+
+	assert.Equal(t, []int{1, 1}, UnionSorted(a, b, cmp.Compare[int]).Take(2).Collect())
+
+	var empty []int
+	assert.Equal(t, empty, IntersectSorted(a, From[int](), cmp.Compare[int]).Collect())
+	assert.Equal(t, a.Collect(), ExceptSorted(a, From[int](), cmp.Compare[int]).Collect())
+}
@@ -0,0 +1,110 @@
+package steams
+
+import "github.com/javiorfo/nilo"
+
+// Iterate returns an infinite iterator yielding seed, next(seed),
+// next(next(seed)) and so on. Combine it with Take or TakeWhile to bound it.
+func Iterate[T any](seed T, next func(T) T) It[T] {
+	return func(yield func(T) bool) {
+		for v := seed; yield(v); v = next(v) {
+		}
+	}
+}
+
+// Repeat returns an infinite iterator that yields v over and over.
+func Repeat[T any](v T) It[T] {
+	return func(yield func(T) bool) {
+		for yield(v) {
+		}
+	}
+}
+
+// RepeatN returns an iterator that yields v exactly n times.
+func RepeatN[T any](v T, n int) It[T] {
+	return func(yield func(T) bool) {
+		for range n {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Cycle returns an infinite iterator that yields the elements of the input
+// over and over. The first pass is buffered so the input is only consumed
+// once. If the input is empty, the result is empty as well.
+func Cycle[T any](i It[T]) It[T] {
+	return func(yield func(T) bool) {
+		var buf []T
+		for v := range i {
+			buf = append(buf, v)
+			if !yield(v) {
+				return
+			}
+		}
+		if len(buf) == 0 {
+			return
+		}
+
+		for {
+			for _, v := range buf {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Generate returns an infinite iterator that yields the result of calling
+// the supplier function each time an element is requested.
+func Generate[T any](supplier func() T) It[T] {
+	return func(yield func(T) bool) {
+		for yield(supplier()) {
+		}
+	}
+}
+
+// Unfold returns an iterator built from an initial state. For each step,
+// the function returns the element to yield paired with the next state,
+// or a Nil option to stop.
+func Unfold[S, T any](state S, fn func(S) nilo.Option[Pair[T, S]]) It[T] {
+	return func(yield func(T) bool) {
+		current := state
+		for {
+			step := fn(current)
+			if step.IsNil() {
+				return
+			}
+
+			p := step.AsValue()
+			if !yield(p.First) {
+				return
+			}
+			current = p.Second
+		}
+	}
+}
+
+// Range returns an iterator yielding numbers from start (inclusive) to end
+// (exclusive), advancing by step. A negative step counts down; a zero step
+// yields nothing.
+func Range[T Number](start, end, step T) It[T] {
+	return func(yield func(T) bool) {
+		var zero T
+		switch {
+		case step > zero:
+			for v := start; v < end; v += step {
+				if !yield(v) || v+step < v {
+					return
+				}
+			}
+		case step < zero:
+			for v := start; v > end; v += step {
+				if !yield(v) || v+step > v {
+					return
+				}
+			}
+		}
+	}
+}
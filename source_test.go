@@ -0,0 +1,68 @@
+package steams
+
+import (
+	"testing"
+
+	"github.com/javiorfo/nilo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterate(t *testing.T) {
+	powers := Iterate(1, func(i int) int { return i * 2 }).Take(5)
+	assert.Equal(t, []int{1, 2, 4, 8, 16}, powers.Collect())
+
+	bounded := Iterate(1, func(i int) int { return i + 3 }).TakeWhile(func(i int) bool { return i < 10 })
+	assert.Equal(t, []int{1, 4, 7}, bounded.Collect())
+}
+
+func TestRepeat(t *testing.T) {
+	assert.Equal(t, []string{"x", "x", "x"}, Repeat("x").Take(3).Collect())
+	assert.Equal(t, []int{7, 7}, RepeatN(7, 2).Collect())
+	assert.Len(t, RepeatN(7, 0).Collect(), 0)
+	assert.Len(t, RepeatN(7, -1).Collect(), 0)
+}
+
+func TestCycle(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3, 1, 2, 3, 1}, Cycle(From(1, 2, 3)).Take(7).Collect())
+	assert.Len(t, Cycle(From[int]()).Collect(), 0)
+
+	consumed := 0
+	source := It[int](func(yield func(int) bool) {
+		consumed++
+		for _, v := range []int{1, 2} {
+			if !yield(v) {
+				return
+			}
+		}
+	})
+	Cycle(source).Take(6).Collect()
+	assert.Equal(t, 1, consumed, "the input should only be consumed once")
+}
+
+func TestGenerate(t *testing.T) {
+	counter := 0
+	gen := Generate(func() int {
+		counter++
+		return counter * 10
+	})
+	assert.Equal(t, []int{10, 20, 30}, gen.Take(3).Collect())
+}
+
+func TestUnfold(t *testing.T) {
+	fib := Unfold(Pair[int, int]{0, 1}, func(s Pair[int, int]) nilo.Option[Pair[int, Pair[int, int]]] {
+		if s.First > 20 {
+			return nilo.Nil[Pair[int, Pair[int, int]]]()
+		}
+		return nilo.Value(Pair[int, Pair[int, int]]{s.First, Pair[int, int]{s.Second, s.First + s.Second}})
+	})
+	assert.Equal(t, []int{0, 1, 1, 2, 3, 5, 8, 13}, fib.Collect())
+}
+
+func TestRange(t *testing.T) {
+	assert.Equal(t, []int{0, 2, 4}, Range(0, 5, 2).Collect())
+	assert.Equal(t, []int{5, 3, 1}, Range(5, 0, -2).Collect())
+	assert.Equal(t, []float64{0, 0.5, 1, 1.5}, Range(0, 2, 0.5).Collect())
+	assert.Len(t, Range(0, 5, 0).Collect(), 0)
+	assert.Len(t, Range(5, 0, 1).Collect(), 0)
+	assert.Equal(t, []uint8{250}, Range[uint8](250, 255, 10).Collect(), "overflow must not wrap around")
+}
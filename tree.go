@@ -0,0 +1,178 @@
+package steams
+
+// PreOrder returns an iterator that walks the tree rooted at root depth
+// first, yielding every node before its children. The children function
+// is only called when a node is reached, so stopping early (for example
+// with TakeWhile) prunes the rest of the traversal. It does not detect
+// cycles; use PreOrderBy for graphs that may contain them.
+func PreOrder[T any](root T, children func(T) It[T]) It[T] {
+	return newTreeWalk[T, struct{}](children, nil).depthFirst(root, -1, false)
+}
+
+// PreOrderBy is a PreOrder variant that identifies nodes by the key
+// returned from keyFunc. A node whose key was already visited is skipped
+// together with its subtree, which protects the traversal against cycles.
+func PreOrderBy[T any, K comparable](root T, children func(T) It[T], keyFunc func(T) K) It[T] {
+	return newTreeWalk(children, keyFunc).depthFirst(root, -1, false)
+}
+
+// PostOrder returns an iterator that walks the tree rooted at root depth
+// first, yielding every node after all of its children. It does not detect
+// cycles; use PostOrderBy for graphs that may contain them.
+func PostOrder[T any](root T, children func(T) It[T]) It[T] {
+	return newTreeWalk[T, struct{}](children, nil).depthFirst(root, -1, true)
+}
+
+// PostOrderBy is a PostOrder variant that identifies nodes by the key
+// returned from keyFunc, skipping nodes whose key was already visited.
+func PostOrderBy[T any, K comparable](root T, children func(T) It[T], keyFunc func(T) K) It[T] {
+	return newTreeWalk(children, keyFunc).depthFirst(root, -1, true)
+}
+
+// LevelOrder returns an iterator that walks the tree rooted at root
+// breadth first, yielding the nodes level by level. Note: the nodes of the
+// next level are queued in memory. It does not detect cycles; use
+// LevelOrderBy for graphs that may contain them.
+func LevelOrder[T any](root T, children func(T) It[T]) It[T] {
+	return newTreeWalk[T, struct{}](children, nil).breadthFirst(root)
+}
+
+// LevelOrderBy is a LevelOrder variant that identifies nodes by the key
+// returned from keyFunc, skipping nodes whose key was already visited.
+func LevelOrderBy[T any, K comparable](root T, children func(T) It[T], keyFunc func(T) K) It[T] {
+	return newTreeWalk(children, keyFunc).breadthFirst(root)
+}
+
+// DepthLimited returns a PreOrder iterator that does not descend below
+// maxDepth. The root is at depth 0, so a maxDepth of 0 yields only the
+// root and a negative maxDepth yields nothing. Since deeper levels are
+// never expanded, it is safe on cyclic or infinite trees.
+func DepthLimited[T any](root T, children func(T) It[T], maxDepth int) It[T] {
+	if maxDepth < 0 {
+		return From[T]()
+	}
+	return newTreeWalk[T, struct{}](children, nil).depthFirst(root, maxDepth, false)
+}
+
+// DepthLimitedBy is a DepthLimited variant that identifies nodes by the
+// key returned from keyFunc, skipping nodes whose key was already visited.
+func DepthLimitedBy[T any, K comparable](root T, children func(T) It[T], maxDepth int, keyFunc func(T) K) It[T] {
+	if maxDepth < 0 {
+		return From[T]()
+	}
+	return newTreeWalk(children, keyFunc).depthFirst(root, maxDepth, false)
+}
+
+// PreOrderWithPath is a PreOrder variant that yields every node together
+// with its ancestors, from the root down to its parent. The path of the
+// root is empty. Each yielded path is a fresh copy that can be retained.
+// Note: an It2 cannot be keyed by the path because slices are not
+// comparable, so the pairs are yielded as an It[Pair[[]T, T]].
+func PreOrderWithPath[T any](root T, children func(T) It[T]) It[Pair[[]T, T]] {
+	return newTreeWalk[T, struct{}](children, nil).withPath(root)
+}
+
+// PreOrderWithPathBy is a PreOrderWithPath variant that identifies nodes
+// by the key returned from keyFunc, skipping nodes whose key was already
+// visited.
+func PreOrderWithPathBy[T any, K comparable](root T, children func(T) It[T], keyFunc func(T) K) It[Pair[[]T, T]] {
+	return newTreeWalk(children, keyFunc).withPath(root)
+}
+
+// treeWalk holds the configuration shared by the traversal functions.
+// When keyFunc is nil no cycle protection is applied.
+type treeWalk[T any, K comparable] struct {
+	children func(T) It[T]
+	keyFunc  func(T) K
+}
+
+func newTreeWalk[T any, K comparable](children func(T) It[T], keyFunc func(T) K) treeWalk[T, K] {
+	return treeWalk[T, K]{children: children, keyFunc: keyFunc}
+}
+
+// visitor returns a function reporting whether a node is visited for the
+// first time. A new one is created on every traversal so the resulting
+// iterators can be consumed more than once.
+func (w treeWalk[T, K]) visitor() func(T) bool {
+	if w.keyFunc == nil {
+		return func(T) bool { return true }
+	}
+	seen := make(map[K]struct{})
+	return func(node T) bool {
+		key := w.keyFunc(node)
+		if _, exists := seen[key]; exists {
+			return false
+		}
+		seen[key] = struct{}{}
+		return true
+	}
+}
+
+// depthFirst walks the tree yielding nodes in pre or post order. A
+// negative maxDepth means the depth is not limited.
+func (w treeWalk[T, K]) depthFirst(root T, maxDepth int, post bool) It[T] {
+	return func(yield func(T) bool) {
+		w.walk(root, maxDepth, post, func(_ []T, node T) bool {
+			return yield(node)
+		})
+	}
+}
+
+// withPath walks the tree in pre order, yielding a copy of the ancestors
+// of each node along with it.
+func (w treeWalk[T, K]) withPath(root T) It[Pair[[]T, T]] {
+	return func(yield func(Pair[[]T, T]) bool) {
+		w.walk(root, -1, false, func(path []T, node T) bool {
+			return yield(Pair[[]T, T]{First: append([]T{}, path...), Second: node})
+		})
+	}
+}
+
+// walk performs the depth first traversal, passing each node and the
+// shared slice of its ancestors to visit.
+func (w treeWalk[T, K]) walk(root T, maxDepth int, post bool, visit func([]T, T) bool) {
+	firstVisit := w.visitor()
+	var path []T
+
+	var rec func(node T) bool
+	rec = func(node T) bool {
+		if !firstVisit(node) {
+			return true
+		}
+		if !post && !visit(path, node) {
+			return false
+		}
+		if maxDepth < 0 || len(path) < maxDepth {
+			path = append(path, node)
+			for child := range w.children(node) {
+				if !rec(child) {
+					return false
+				}
+			}
+			path = path[:len(path)-1]
+		}
+		return !post || visit(path, node)
+	}
+	rec(root)
+}
+
+// breadthFirst walks the tree level by level.
+func (w treeWalk[T, K]) breadthFirst(root T) It[T] {
+	return func(yield func(T) bool) {
+		firstVisit := w.visitor()
+		queue := []T{root}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			if !firstVisit(node) {
+				continue
+			}
+			if !yield(node) {
+				return
+			}
+			for child := range w.children(node) {
+				queue = append(queue, child)
+			}
+		}
+	}
+}
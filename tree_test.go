@@ -0,0 +1,102 @@
+package steams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type treeNode struct {
+	Name     string
+	Children []*treeNode
+}
+
+func newTreeNode(name string, children ...*treeNode) *treeNode {
+	return &treeNode{Name: name, Children: children}
+}
+
+func nodeChildren(n *treeNode) It[*treeNode] {
+	return FromSlice(n.Children)
+}
+
+func nodeName(n *treeNode) string {
+	return n.Name
+}
+
+// sampleTree builds the tree a(b(c, d), e(f)).
+func sampleTree() *treeNode {
+	return newTreeNode("a", newTreeNode("b", newTreeNode("c"), newTreeNode("d")), newTreeNode("e", newTreeNode("f")))
+}
+
+func TestPreOrder(t *testing.T) {
+	names := Map(PreOrder(sampleTree(), nodeChildren), nodeName).Collect()
+	assert.Equal(t, []string{"a", "b", "c", "d", "e", "f"}, names)
+}
+
+func TestPostOrder(t *testing.T) {
+	names := Map(PostOrder(sampleTree(), nodeChildren), nodeName).Collect()
+	assert.Equal(t, []string{"c", "d", "b", "f", "e", "a"}, names)
+}
+
+func TestLevelOrder(t *testing.T) {
+	names := Map(LevelOrder(sampleTree(), nodeChildren), nodeName).Collect()
+	assert.Equal(t, []string{"a", "b", "e", "c", "d", "f"}, names)
+}
+
+func TestDepthLimited(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "e"}, Map(DepthLimited(sampleTree(), nodeChildren, 1), nodeName).Collect())
+	assert.Equal(t, []string{"a"}, Map(DepthLimited(sampleTree(), nodeChildren, 0), nodeName).Collect())
+	assert.Empty(t, DepthLimited(sampleTree(), nodeChildren, -1).Collect())
+
+	// An infinite tree is safe when the depth is limited.
+	binary := func(n int) It[int] { return From(2*n, 2*n+1) }
+	assert.Equal(t, []int{1, 2, 4, 5, 3, 6, 7}, DepthLimited(1, binary, 2).Collect())
+}
+
+func TestPreOrderWithPath(t *testing.T) {
+	var got []string
+	for p := range PreOrderWithPath(sampleTree(), nodeChildren) {
+		path := JoinStrings(Map(FromSlice(p.First), nodeName), "/")
+		got = append(got, path+":"+p.Second.Name)
+	}
+	assert.Equal(t, []string{":a", "a:b", "a/b:c", "a/b:d", "a:e", "a/e:f"}, got)
+
+	paths := PreOrderWithPath(sampleTree(), nodeChildren).Collect()
+	assert.Equal(t, []string{"a", "b"}, Map(FromSlice(paths[2].First), nodeName).Collect())
+}
+
+func TestTraversalCycles(t *testing.T) {
+	graph := map[int][]int{1: {2, 3}, 2: {3, 1}, 3: {1}}
+	neighbours := func(n int) It[int] { return FromSlice(graph[n]) }
+
+	assert.Equal(t, []int{1, 2, 3}, PreOrderBy(1, neighbours, identity[int]).Collect())
+	assert.Equal(t, []int{3, 2, 1}, PostOrderBy(1, neighbours, identity[int]).Collect())
+	assert.Equal(t, []int{1, 2, 3}, LevelOrderBy(1, neighbours, identity[int]).Collect())
+	assert.Equal(t, []int{1, 2}, DepthLimitedBy(1, neighbours, 1, identity[int]).Filter(func(n int) bool { return n < 3 }).Collect())
+
+	paths := PreOrderWithPathBy(1, neighbours, identity[int]).Collect()
+	assert.Equal(t, []int{1, 2}, paths[2].First)
+	assert.Equal(t, 3, paths[2].Second)
+}
+
+func TestTraversalIsLazy(t *testing.T) {
+	expanded := 0
+	children := func(n *treeNode) It[*treeNode] {
+		expanded++
+		return nodeChildren(n)
+	}
+
+	names := Map(PreOrder(sampleTree(), children), nodeName).TakeWhile(func(s string) bool { return s != "c" }).Collect()
+	assert.Equal(t, []string{"a", "b"}, names)
+	assert.Equal(t, 2, expanded)
+
+	expanded = 0
+	first := Map(LevelOrder(sampleTree(), children), nodeName).Take(1).Collect()
+	assert.Equal(t, []string{"a"}, first)
+	assert.Equal(t, 0, expanded)
+
+	// Traversals can be consumed more than once.
+	it := PreOrderBy(sampleTree(), nodeChildren, nodeName)
+	assert.Equal(t, 6, it.Count())
+	assert.Equal(t, 6, it.Count())
+}
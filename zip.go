@@ -0,0 +1,106 @@
+package steams
+
+import (
+	"iter"
+
+	"github.com/javiorfo/nilo"
+)
+
+// Pair is a generic struct that holds two values of possibly different types.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple is a generic struct that holds three values of possibly different types.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// ZipWith combines two iterators by applying the zipper function to each
+// pair of elements. It stops as soon as either input iterator is exhausted.
+func ZipWith[A, B, R any](i1 It[A], i2 It[B], zipper func(A, B) R) It[R] {
+	return func(yield func(R) bool) {
+		next1, stop1 := iter.Pull(iter.Seq[A](i1))
+		defer stop1()
+		next2, stop2 := iter.Pull(iter.Seq[B](i2))
+		defer stop2()
+
+		for {
+			val1, ok1 := next1()
+			if !ok1 {
+				return
+			}
+			val2, ok2 := next2()
+			if !ok2 {
+				return
+			}
+
+			if !yield(zipper(val1, val2)) {
+				return
+			}
+		}
+	}
+}
+
+// Zip3 combines three iterators into a single iterator of triples.
+// It stops as soon as any input iterator is exhausted.
+func Zip3[A, B, C any](i1 It[A], i2 It[B], i3 It[C]) It[Triple[A, B, C]] {
+	return ZipWith(Zip(i1, i2), i3, func(p Pair[A, B], v3 C) Triple[A, B, C] {
+		return Triple[A, B, C]{First: p.First, Second: p.Second, Third: v3}
+	})
+}
+
+// ZipLongest combines two iterators into pairs until both are exhausted.
+// Once the shorter iterator runs out, its side of the pair is a Nil option.
+func ZipLongest[A, B any](i1 It[A], i2 It[B]) It[Pair[nilo.Option[A], nilo.Option[B]]] {
+	return func(yield func(Pair[nilo.Option[A], nilo.Option[B]]) bool) {
+		next1, stop1 := iter.Pull(iter.Seq[A](i1))
+		defer stop1()
+		next2, stop2 := iter.Pull(iter.Seq[B](i2))
+		defer stop2()
+
+		for {
+			val1, ok1 := next1()
+			val2, ok2 := next2()
+			if !ok1 && !ok2 {
+				return
+			}
+
+			pair := Pair[nilo.Option[A], nilo.Option[B]]{First: nilo.Nil[A](), Second: nilo.Nil[B]()}
+			if ok1 {
+				pair.First = nilo.Value(val1)
+			}
+			if ok2 {
+				pair.Second = nilo.Value(val2)
+			}
+
+			if !yield(pair) {
+				return
+			}
+		}
+	}
+}
+
+// Unzip splits an iterator of pairs into two lazy iterators, one for the
+// first values and one for the second values. Each returned iterator
+// traverses the input independently.
+func Unzip[A, B any](i It[Pair[A, B]]) (It[A], It[B]) {
+	firsts := Map(i, func(p Pair[A, B]) A { return p.First })
+	seconds := Map(i, func(p Pair[A, B]) B { return p.Second })
+	return firsts, seconds
+}
+
+// ZipToIt2 combines an iterator of keys and an iterator of values into a
+// key-value iterator. It stops as soon as either input iterator is exhausted.
+func ZipToIt2[K comparable, V any](keys It[K], values It[V]) It2[K, V] {
+	return func(yield func(K, V) bool) {
+		for p := range Zip(keys, values) {
+			if !yield(p.First, p.Second) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package steams
+
+import (
+	"testing"
+
+	"github.com/javiorfo/nilo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZipWith(t *testing.T) {
+	sums := ZipWith(From(1, 2, 3), From(10, 20), func(a, b int) int { return a + b })
+	assert.Equal(t, []int{11, 22}, sums.Collect())
+
+	pulled := 0
+	counted := It[int](func(yield func(int) bool) {
+		for _, v := range []int{1, 2, 3} {
+			pulled++
+			if !yield(v) {
+				return
+			}
+		}
+	})
+	ZipWith(From[int](), counted, func(a, b int) int { return a + b }).Collect()
+	assert.Equal(t, 0, pulled, "second iterator should not be pulled when the first is empty")
+}
+
+func TestZip3(t *testing.T) {
+	result := Zip3(From(1, 2), From("a", "b", "c"), From(true, false)).Collect()
+	assert.Equal(t, []Triple[int, string, bool]{{1, "a", true}, {2, "b", false}}, result)
+}
+
+func TestZipLongest(t *testing.T) {
+	result := ZipLongest(From(1, 2, 3), From("a")).Collect()
+	assert.Equal(t, []Pair[nilo.Option[int], nilo.Option[string]]{
+		{nilo.Value(1), nilo.Value("a")},
+		{nilo.Value(2), nilo.Nil[string]()},
+		{nilo.Value(3), nilo.Nil[string]()},
+	}, result)
+
+	assert.Len(t, ZipLongest(From[int](), From(1, 2)).Collect(), 2)
+	assert.Len(t, ZipLongest(From[int](), From[int]()).Collect(), 0)
+}
+
+func TestUnzip(t *testing.T) {
+	pairs := Zip(From(1, 2, 3), From("a", "b", "c"))
+	nums, letters := Unzip(pairs)
+	assert.Equal(t, []int{1, 2, 3}, nums.Collect())
+	assert.Equal(t, []string{"a", "b", "c"}, letters.Collect())
+}
+
+func TestZipToIt2(t *testing.T) {
+	m := ZipToIt2(From("a", "b", "c"), From(1, 2)).Collect()
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, m)
+
+	filtered := ZipToIt2(From("a", "b"), From(1, 2)).Filter(func(k string, v int) bool { return v > 1 })
+	assert.Equal(t, map[string]int{"b": 2}, filtered.Collect())
+}